@@ -0,0 +1,3 @@
+//go:generate go run github.com/oapi-codegen/oapi-codegen/v2/cmd/oapi-codegen -config ./oapi-codegen.yaml ./openapi_v3.yaml
+
+package ruler
@@ -10,6 +10,7 @@ import (
 const (
 	TracerVersion      = "0.1.0"
 	DefaultTenantLabel = "tenant"
+	DefaultRulerPath   = "/prometheus"
 )
 
 type ServerConfig struct {
@@ -29,8 +30,50 @@ func (c *ServerConfig) GetTracerUrl() string {
 
 type AlertsConfig struct {
 	AlertmanagerUrl string
-	Tenants         []string
-	TenantLabel     string
+	// RulerUrl is the base URL of the Prometheus compatible API of the Mimir ruler.
+	// Default: the /prometheus path on the host of AlertmanagerUrl
+	RulerUrl string
+	// ConfigUrl is the base URL of the Alertmanager configuration API (/api/v1/alerts) of Mimir.
+	// Default: the root path on the host of AlertmanagerUrl
+	ConfigUrl string
+	// ConfigBaseline is the path of the central Alertmanager configuration file, the tenant configurations are compared to it
+	ConfigBaseline string
+	// ConfigPush enables pushing Alertmanager configurations to the tenants over the API and by the tenant-configs command
+	ConfigPush bool
+	// AckPath is the file of the alert acknowledgements, the acknowledgements are kept in memory only, if empty
+	AckPath string
+	// AckResolvePeriodSec is the period of dropping the acknowledgements of the resolved alerts, 60 s by default
+	AckResolvePeriodSec int
+	// ActionLinkSecret is the HMAC key of the signed action links of the notifications, the action links are disabled if empty
+	ActionLinkSecret string
+	// ActionLinkTTLSec is the validity of the action links, 7 days by default
+	ActionLinkTTLSec int
+	Tenants          []string
+	TenantLabel      string
+	TenantMeta       *TenantMetaConfig
+}
+
+// TenantMetaConfig is the per-tenant metadata (team, owner, Slack channel, runbook base URL, etc.)
+// The aggregator injects the metadata into the alerts as labels and annotations.
+type TenantMetaConfig struct {
+	// Tenants contains the metadata key-values by tenant ID
+	Tenants map[string]map[string]string
+	// Labels maps metadata keys to alert label names
+	Labels map[string]string
+	// Annotations maps metadata keys to alert annotation names
+	Annotations map[string]string
+	// Override overwrites the existing labels and annotations of the alerts, they are kept by default.
+	// An overwritten label changes the fingerprint of the alert.
+	Override bool
+}
+
+// GetTenantMeta returns the metadata value of a tenant. Empty string is returned, if not found.
+func (c *AlertsConfig) GetTenantMeta(tenant string, key string) string {
+	if c == nil || c.TenantMeta == nil {
+		return ""
+	}
+
+	return c.TenantMeta.Tenants[tenant][key]
 }
 
 type NotifyerConfig struct {
@@ -40,6 +83,171 @@ type NotifyerConfig struct {
 	Receivers       []am_config.Receiver `yaml:"receivers,omitempty" json:"receivers,omitempty"`
 	Templates       []string             `yaml:"templates" json:"templates"`
 	PollPeriodSec   int
+	// TemplateFiles are the paths and globs of the template files, loaded after Templates, each must match a file.
+	// The files are read once per poll, the templates are reloaded, if the checksum of the files is changed
+	TemplateFiles []string
+	// GrafanaURL is the base URL of the .DashboardURL and .PanelURL links of the Grafana template data
+	GrafanaURL string
+	// GrafanaReceivers execute the message templates of their email configs on the Grafana template data,
+	// instead of the Alertmanager template data
+	GrafanaReceivers []string
+	// DisappearedGracePolls is the number of the polls, while a missing unresolved alert is kept,
+	// before it's notified as resolved with the disappeared annotation
+	DisappearedGracePolls int
+	// EventBufferSize is the number of alert events kept for resuming the event stream
+	EventBufferSize int
+	// DryRun renders the notifications of all receivers and records them in the dry-run log, instead of sending them
+	DryRun bool
+	// DryRunReceivers are the receivers in dry-run mode, see DryRun
+	DryRunReceivers []string
+	// DryRunLogSize is the number of the notifications kept in the dry-run log
+	DryRunLogSize int
+	// HistoryPath is the file of the alert state transitions, the history is kept in memory only, if empty
+	HistoryPath string
+	// HistoryRetentionHours is the retention of the alert history
+	HistoryRetentionHours int
+	// FlapDetection is the flap detection of the routes, disabled by default
+	FlapDetection FlapDetectionConfig
+	// RouteFlapDetection overrides FlapDetection for a route and its child routes
+	RouteFlapDetection []RouteFlapDetectionConfig
+	// ChangeDetection re-notifies the firing alerts on label or annotation changes, disabled by default
+	ChangeDetection ChangeDetectionConfig
+	// TimeIntervals are the named time intervals of the muteTimeIntervals and activeTimeIntervals of the routes
+	TimeIntervals []TimeIntervalConfig
+	// Escalations are the escalation chains of the routes for the unacknowledged firing alert groups
+	Escalations []EscalationConfig
+	// Digests are the digest receivers, which get a scheduled summary instead of a notification per alert group
+	Digests []DigestConfig
+	// Recipients are the dynamic recipients of the receivers
+	Recipients []RecipientsConfig
+	// StormProtection limits the notifications of the receivers
+	StormProtection []StormProtectionConfig
+	// TenantConfigPath is a file or a directory of the tenant notifyer configs, see TenantNotifyerConfig
+	TenantConfigPath string
+	// TenantEmailDefaults are the SMTP settings of the tenant email configs without smarthost, and the default sender
+	TenantEmailDefaults *am_config.EmailConfig
+}
+
+// RecipientsConfig resolves the email recipients of a receiver from the common labels and annotations of the alert group,
+// instead of the static To of its email configs. Only email integrations are supported.
+// The addresses are deduplicated and filtered by the allowed domains.
+type RecipientsConfig struct {
+	Receiver string
+	// To is the template of the comma separated To addresses, for example: {{ .CommonLabels.owner_email }}
+	To string
+	// Cc is the template of the comma separated Cc addresses, for example: {{ .CommonLabels.team }}@example.com
+	Cc string
+	// AllowedDomains are the allowed domains (and their subdomains) of the templated addresses, required for templated To or Cc.
+	// The static (non-templated) addresses are not checked.
+	AllowedDomains []string
+	// FallbackReceiver is notified, if no allowed To address is resolved. The group is dropped, if not set.
+	FallbackReceiver string
+}
+
+// StormProtectionConfig limits the notifications of a receiver in a sliding window.
+// Above the storm threshold, one summary notification is sent and the individual notifications are held back,
+// until the number of the alerts to be notified in the window falls to the threshold.
+// The held back alerts are sent in one catch-up summary, when the storm ends and the rate limit allows it.
+type StormProtectionConfig struct {
+	Receiver  string
+	WindowSec int
+	// MaxNotifications is the maximum number of the notifications in the window, unlimited, if 0.
+	// The notifications above the limit are held back, too.
+	MaxNotifications int
+	// StormThreshold is the maximum number of the alerts to be notified in the window, disabled, if 0
+	StormThreshold int
+}
+
+// TenantNotifyerConfig is the route subtree, receivers and templates of a tenant, in Alertmanager config format.
+// The route is mounted under the root route with an implicit tenant matcher, after the central child routes.
+// The receivers get the "<tenant>/" name prefix, the templates must define "<tenant>." prefixed templates only.
+// In a directory, the tenant is the file name without extension, if not set.
+type TenantNotifyerConfig struct {
+	Tenant    string               `yaml:"tenant,omitempty" json:"tenant,omitempty"`
+	Route     *am_config.Route     `yaml:"route,omitempty" json:"route,omitempty"`
+	Receivers []am_config.Receiver `yaml:"receivers,omitempty" json:"receivers,omitempty"`
+	// Templates are template file globs, relative to the tenant config file
+	Templates []string `yaml:"templates,omitempty" json:"templates,omitempty"`
+}
+
+// DigestConfig sends the firing alerts of the receiver and the alerts resolved since the last digest
+// at the scheduled times, grouped by tenant and severity.
+type DigestConfig struct {
+	// Receiver gets the digest by its email configs, instead of the notifications of the alert groups
+	Receiver string
+	// Schedule is a cron expression (minute hour day-of-month month day-of-week) or @hourly, @daily, @weekly, @monthly
+	Schedule string
+	// Location is the timezone of the schedule, UTC by default
+	Location string
+	// Template is the name of the HTML body template, the built-in digest template by default
+	Template string
+	// Subject is the template of the subject, the built-in digest subject by default
+	Subject string
+}
+
+// EscalationConfig notifies the next receiver of the chain, if a firing alert group of the route
+// is not resolved or silenced in time. The first notification is sent to the receiver of the route.
+type EscalationConfig struct {
+	// RouteID is the ID of the route, see the routes command
+	RouteID string
+	Steps   []EscalationStepConfig
+}
+
+type EscalationStepConfig struct {
+	Receiver string
+	// DelaySec is the time from the first notification of the alert group
+	DelaySec int
+}
+
+// TimeIntervalConfig is a named list of time intervals, like time_intervals in the Alertmanager config
+type TimeIntervalConfig struct {
+	Name          string
+	TimeIntervals []TimeIntervalSpec
+}
+
+// TimeIntervalSpec is a time interval. The values have the Alertmanager config format, for example:
+// Times: [{StartTime: "09:00", EndTime: "17:00"}], Weekdays: ["monday:friday"], Location: "Europe/Budapest"
+type TimeIntervalSpec struct {
+	Times       []TimeRangeConfig
+	Weekdays    []string
+	DaysOfMonth []string
+	Months      []string
+	Years       []string
+	// Location is the timezone of the interval, UTC by default
+	Location string
+}
+
+type TimeRangeConfig struct {
+	StartTime string
+	EndTime   string
+}
+
+// FlapDetectionConfig detects the alerts, which change their state too often.
+// A flapping alert gets one notification, instead of a notification on every state change.
+type FlapDetectionConfig struct {
+	// Threshold is the number of state changes in the window (including the appearance), which makes an alert flapping, disabled if 0
+	Threshold int
+	// WindowSec is the sliding window of the state changes. A flapping alert is stable again, if it has no state change in the window.
+	WindowSec int
+}
+
+// ChangeDetectionConfig re-notifies the firing alerts, if a value of the labels or annotations is changed.
+// The notified alerts get the changed keys and the previous values as annotations.
+type ChangeDetectionConfig struct {
+	// Labels are the label keys. An alert with a changed label is matched to the missing alert by the other labels.
+	Labels []string
+	// Annotations are the annotation keys
+	Annotations []string
+	// MinIntervalSec is the minimum time between the change notifications of an alert group, not limited if 0
+	MinIntervalSec int
+}
+
+// RouteFlapDetectionConfig is the flap detection of a route, see FlapDetectionConfig
+type RouteFlapDetectionConfig struct {
+	// RouteID is the ID of the route, see the routes command
+	RouteID   string
+	Threshold int
+	WindowSec int
 }
 
 type TestConfig struct {
@@ -3,6 +3,15 @@ package configs
 const (
 	ServiceNameAlertmanager = "multitenant-alertmanager"
 	ServiceNameNotifyer     = "notifyer"
+	ServiceNameWebUI        = "ui"
 
 	HttpHeaderXscopeorgid = "X-Scope-OrgID"
+	// HttpHeaderPartialResponse requests the alerts of the available tenants, instead of an error, if a tenant can't be fetched
+	HttpHeaderPartialResponse = "X-Partial-Response"
+	// HttpHeaderFailedTenants is the comma-separated list of the tenants, which can't be fetched for a partial response
+	HttpHeaderFailedTenants = "X-Failed-Tenants"
+
+	// AckedByAnnotation and AckedAtAnnotation are set on the acknowledged alerts by the aggregator
+	AckedByAnnotation = "acked_by"
+	AckedAtAnnotation = "acked_at"
 )
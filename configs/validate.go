@@ -0,0 +1,501 @@
+package configs
+
+import (
+	"errors"
+	"fmt"
+	"maps"
+	"net"
+	"net/url"
+	"reflect"
+	"slices"
+	"strings"
+	"time"
+
+	am_config "github.com/prometheus/alertmanager/config"
+	prom_model "github.com/prometheus/common/model"
+)
+
+// GroupByAll is the special group_by value for grouping by all labels
+const GroupByAll = "..."
+
+var ErrInvalidServerConfig = errors.New("invalid server config")
+
+// ConfigError is a validation error of the server config.
+// Path is the YAML path of the invalid value, for example notifyer.receivers[0].emailConfigs[0].to
+type ConfigError struct {
+	Path    string `yaml:"path" json:"path"`
+	Message string `yaml:"message" json:"message"`
+}
+
+func (e ConfigError) Error() string {
+	return e.Path + ": " + e.Message
+}
+
+// ConfigErrors is the list of the validation errors of the server config
+type ConfigErrors []ConfigError
+
+func (e ConfigErrors) Error() string {
+	messages := make([]string, 0, len(e))
+	for _, configError := range e {
+		messages = append(messages, configError.Error())
+	}
+
+	return strings.Join(messages, "; ")
+}
+
+// Err returns nil, if there is no validation error, else the errors wrapped by ErrInvalidServerConfig
+func (e ConfigErrors) Err() error {
+	if len(e) == 0 {
+		return nil
+	}
+
+	return fmt.Errorf("%w: %w", ErrInvalidServerConfig, e)
+}
+
+func (e *ConfigErrors) add(path string, format string, args ...any) {
+	*e = append(*e, ConfigError{Path: path, Message: fmt.Sprintf(format, args...)})
+}
+
+// Validate checks the service independent settings of the server config
+func (c *ServerConfig) Validate() ConfigErrors {
+	configErrors := ConfigErrors{}
+	if c.ListenAddr != "" {
+		if _, _, err := net.SplitHostPort(c.ListenAddr); err != nil {
+			configErrors.add("listenAddr", "%s", err)
+		}
+	}
+	if c.TracerUrl != "" {
+		validateUrl(&configErrors, "tracerUrl", c.TracerUrl)
+	}
+
+	return configErrors
+}
+
+// Validate checks the alerts config. A missing alerts config is an error, because all services need it.
+func (c *AlertsConfig) Validate() ConfigErrors {
+	const path = "alerts"
+	configErrors := ConfigErrors{}
+	if c == nil {
+		configErrors.add(path, "missing")
+
+		return configErrors
+	}
+
+	if c.AlertmanagerUrl == "" {
+		configErrors.add(path+".alertmanagerUrl", "missing")
+	} else {
+		validateUrl(&configErrors, path+".alertmanagerUrl", c.AlertmanagerUrl)
+	}
+	if c.RulerUrl != "" {
+		validateUrl(&configErrors, path+".rulerUrl", c.RulerUrl)
+	}
+	if c.ConfigUrl != "" {
+		validateUrl(&configErrors, path+".configUrl", c.ConfigUrl)
+	}
+	if c.TenantLabel != "" && !prom_model.LabelName(c.TenantLabel).IsValid() {
+		configErrors.add(path+".tenantLabel", "invalid label name %q", c.TenantLabel)
+	}
+
+	if c.AckResolvePeriodSec < 0 {
+		configErrors.add(path+".ackResolvePeriodSec", "must not be negative, got %d", c.AckResolvePeriodSec)
+	}
+	if c.ActionLinkTTLSec < 0 {
+		configErrors.add(path+".actionLinkTTLSec", "must not be negative, got %d", c.ActionLinkTTLSec)
+	}
+
+	if len(c.Tenants) == 0 {
+		configErrors.add(path+".tenants", "missing")
+	}
+	for t, tenant := range c.Tenants {
+		if tenant == "" {
+			configErrors.add(fmt.Sprintf("%s.tenants[%d]", path, t), "empty tenant")
+		} else if slices.Index(c.Tenants, tenant) < t {
+			configErrors.add(fmt.Sprintf("%s.tenants[%d]", path, t), "duplicated tenant %q", tenant)
+		}
+	}
+
+	if c.TenantMeta != nil {
+		for _, tenant := range slices.Sorted(maps.Keys(c.TenantMeta.Tenants)) {
+			if !slices.Contains(c.Tenants, tenant) {
+				configErrors.add(path+".tenantMeta.tenants."+tenant, "unknown tenant")
+			}
+		}
+		for _, key := range slices.Sorted(maps.Keys(c.TenantMeta.Labels)) {
+			if label := c.TenantMeta.Labels[key]; !prom_model.LabelName(label).IsValid() {
+				configErrors.add(path+".tenantMeta.labels."+key, "invalid label name %q", label)
+			}
+		}
+		for _, key := range slices.Sorted(maps.Keys(c.TenantMeta.Annotations)) {
+			if annotation := c.TenantMeta.Annotations[key]; !prom_model.LabelName(annotation).IsValid() {
+				configErrors.add(path+".tenantMeta.annotations."+key, "invalid annotation name %q", annotation)
+			}
+		}
+	}
+
+	return configErrors
+}
+
+// Validate checks the notifyer config structurally. The templates are checked by the notifyer.
+func (c *NotifyerConfig) Validate() ConfigErrors {
+	const path = "notifyer"
+	configErrors := ConfigErrors{}
+	if c == nil {
+		configErrors.add(path, "missing")
+
+		return configErrors
+	}
+
+	if c.AlertmanagerUrl != "" {
+		validateUrl(&configErrors, path+".alertmanagerUrl", c.AlertmanagerUrl)
+	}
+	if c.ExternalURL == "" {
+		configErrors.add(path+".externalUrl", "missing")
+	} else {
+		validateUrl(&configErrors, path+".externalUrl", c.ExternalURL)
+	}
+	if c.GrafanaURL != "" {
+		validateUrl(&configErrors, path+".grafanaUrl", c.GrafanaURL)
+	}
+	if c.PollPeriodSec <= 0 {
+		configErrors.add(path+".pollPeriodSec", "must be positive, got %d", c.PollPeriodSec)
+	}
+	if c.DisappearedGracePolls < 0 {
+		configErrors.add(path+".disappearedGracePolls", "must not be negative, got %d", c.DisappearedGracePolls)
+	}
+	if c.EventBufferSize < 0 {
+		configErrors.add(path+".eventBufferSize", "must not be negative, got %d", c.EventBufferSize)
+	}
+
+	receivers := map[string]*am_config.Receiver{}
+	for r, receiver := range c.Receivers {
+		receiverPath := fmt.Sprintf("%s.receivers[%d]", path, r)
+		if receiver.Name == "" {
+			configErrors.add(receiverPath+".name", "missing")
+		} else if _, has := receivers[receiver.Name]; has {
+			configErrors.add(receiverPath+".name", "duplicated receiver %q", receiver.Name)
+		} else {
+			receivers[receiver.Name] = &c.Receivers[r]
+		}
+		for e, emailConfig := range receiver.EmailConfigs {
+			validateEmailConfig(&configErrors, fmt.Sprintf("%s.emailConfigs[%d]", receiverPath, e), emailConfig)
+		}
+		if integrations := unsupportedIntegrations(receiver); len(integrations) > 0 {
+			configErrors.add(receiverPath, "only email configs are supported, got %s", strings.Join(integrations, ", "))
+		}
+	}
+
+	for r, receiver := range c.DryRunReceivers {
+		if _, has := receivers[receiver]; !has {
+			configErrors.add(fmt.Sprintf("%s.dryRunReceivers[%d]", path, r), "undefined receiver %q", receiver)
+		}
+	}
+	for r, receiver := range c.GrafanaReceivers {
+		if _, has := receivers[receiver]; !has {
+			configErrors.add(fmt.Sprintf("%s.grafanaReceivers[%d]", path, r), "undefined receiver %q", receiver)
+		}
+	}
+	if c.DryRunLogSize < 0 {
+		configErrors.add(path+".dryRunLogSize", "must not be negative, got %d", c.DryRunLogSize)
+	}
+
+	if c.HistoryRetentionHours < 0 {
+		configErrors.add(path+".historyRetentionHours", "must not be negative, got %d", c.HistoryRetentionHours)
+	}
+
+	validateFlapDetection(&configErrors, path+".flapDetection", c.FlapDetection)
+	for r, routeFlapDetection := range c.RouteFlapDetection {
+		routePath := fmt.Sprintf("%s.routeFlapDetection[%d]", path, r)
+		if routeFlapDetection.RouteID == "" {
+			configErrors.add(routePath+".routeId", "missing")
+		}
+		validateFlapDetection(&configErrors, routePath, FlapDetectionConfig{
+			Threshold: routeFlapDetection.Threshold, WindowSec: routeFlapDetection.WindowSec,
+		})
+	}
+
+	validateChangeDetection(&configErrors, path+".changeDetection", c.ChangeDetection)
+
+	timeIntervals := map[string]bool{}
+	for t, timeInterval := range c.TimeIntervals {
+		intervalPath := fmt.Sprintf("%s.timeIntervals[%d].name", path, t)
+		if timeInterval.Name == "" {
+			configErrors.add(intervalPath, "missing")
+		} else if timeIntervals[timeInterval.Name] {
+			configErrors.add(intervalPath, "duplicated time interval %q", timeInterval.Name)
+		}
+		timeIntervals[timeInterval.Name] = true
+	}
+
+	for e, escalation := range c.Escalations {
+		escalationPath := fmt.Sprintf("%s.escalations[%d]", path, e)
+		if escalation.RouteID == "" {
+			configErrors.add(escalationPath+".routeId", "missing")
+		}
+		if len(escalation.Steps) == 0 {
+			configErrors.add(escalationPath+".steps", "missing")
+		}
+		lastDelaySec := 0
+		for s, step := range escalation.Steps {
+			stepPath := fmt.Sprintf("%s.steps[%d]", escalationPath, s)
+			if _, has := receivers[step.Receiver]; !has {
+				configErrors.add(stepPath+".receiver", "undefined receiver %q", step.Receiver)
+			}
+			if step.DelaySec <= lastDelaySec {
+				configErrors.add(stepPath+".delaySec", "must be greater than %d, got %d", lastDelaySec, step.DelaySec)
+			}
+			lastDelaySec = step.DelaySec
+		}
+	}
+
+	digests := map[string]bool{}
+	for d, digest := range c.Digests {
+		digestPath := fmt.Sprintf("%s.digests[%d]", path, d)
+		if _, has := receivers[digest.Receiver]; !has {
+			configErrors.add(digestPath+".receiver", "undefined receiver %q", digest.Receiver)
+		} else if digests[digest.Receiver] {
+			configErrors.add(digestPath+".receiver", "duplicated digest receiver %q", digest.Receiver)
+		}
+		digests[digest.Receiver] = true
+		if digest.Schedule == "" {
+			configErrors.add(digestPath+".schedule", "missing")
+		}
+		if _, err := time.LoadLocation(digest.Location); err != nil {
+			configErrors.add(digestPath+".location", "%s", err.Error())
+		}
+	}
+
+	recipients := map[string]bool{}
+	for r, recipient := range c.Recipients {
+		recipientPath := fmt.Sprintf("%s.recipients[%d]", path, r)
+		if _, has := receivers[recipient.Receiver]; !has {
+			configErrors.add(recipientPath+".receiver", "undefined receiver %q", recipient.Receiver)
+		} else if recipients[recipient.Receiver] {
+			configErrors.add(recipientPath+".receiver", "duplicated recipients of receiver %q", recipient.Receiver)
+		}
+		recipients[recipient.Receiver] = true
+		if recipient.To == "" {
+			configErrors.add(recipientPath+".to", "missing")
+		}
+		for d, domain := range recipient.AllowedDomains {
+			if domain == "" || strings.ContainsAny(domain, "@ ") {
+				configErrors.add(fmt.Sprintf("%s.allowedDomains[%d]", recipientPath, d), "invalid domain %q", domain)
+			}
+		}
+		if len(recipient.AllowedDomains) == 0 && (strings.Contains(recipient.To, "{{") || strings.Contains(recipient.Cc, "{{")) {
+			configErrors.add(recipientPath+".allowedDomains", "required for templated recipients")
+		}
+		if recipient.FallbackReceiver != "" {
+			if _, has := receivers[recipient.FallbackReceiver]; !has {
+				configErrors.add(recipientPath+".fallbackReceiver", "undefined receiver %q", recipient.FallbackReceiver)
+			} else if recipient.FallbackReceiver == recipient.Receiver {
+				configErrors.add(recipientPath+".fallbackReceiver", "must differ from the receiver")
+			}
+		}
+	}
+
+	storms := map[string]bool{}
+	for r, storm := range c.StormProtection {
+		stormPath := fmt.Sprintf("%s.stormProtection[%d]", path, r)
+		if _, has := receivers[storm.Receiver]; !has {
+			configErrors.add(stormPath+".receiver", "undefined receiver %q", storm.Receiver)
+		} else if storms[storm.Receiver] {
+			configErrors.add(stormPath+".receiver", "duplicated storm protection of receiver %q", storm.Receiver)
+		}
+		storms[storm.Receiver] = true
+		if storm.MaxNotifications < 0 {
+			configErrors.add(stormPath+".maxNotifications", "must not be negative, got %d", storm.MaxNotifications)
+		}
+		if storm.StormThreshold < 0 {
+			configErrors.add(stormPath+".stormThreshold", "must not be negative, got %d", storm.StormThreshold)
+		}
+		if storm.WindowSec <= 0 {
+			configErrors.add(stormPath+".windowSec", "must be positive, got %d", storm.WindowSec)
+		}
+	}
+
+	if c.Route == nil {
+		configErrors.add(path+".route", "missing")
+	} else {
+		if c.Route.Receiver == "" {
+			configErrors.add(path+".route.receiver", "missing")
+		}
+		validateRoute(&configErrors, path+".route", c.Route, receivers, timeIntervals)
+	}
+
+	return configErrors
+}
+
+// Validate checks the tenant notifyer config. The route and its child routes must not have matchers,
+// which reject the alerts of the tenant, so the config cannot match the alerts of other tenants.
+// The tenants are not checked, if empty.
+func (c *TenantNotifyerConfig) Validate(path string, tenantLabel string, tenants []string, timeIntervals []TimeIntervalConfig,
+) ConfigErrors {
+	configErrors := ConfigErrors{}
+	if c.Tenant == "" {
+		configErrors.add(path+".tenant", "missing")
+	} else if len(tenants) > 0 && !slices.Contains(tenants, c.Tenant) {
+		configErrors.add(path+".tenant", "unknown tenant %q", c.Tenant)
+	}
+
+	receivers := map[string]*am_config.Receiver{}
+	for r, receiver := range c.Receivers {
+		receiverPath := fmt.Sprintf("%s.receivers[%d]", path, r)
+		if receiver.Name == "" {
+			configErrors.add(receiverPath+".name", "missing")
+		} else if _, has := receivers[receiver.Name]; has {
+			configErrors.add(receiverPath+".name", "duplicated receiver %q", receiver.Name)
+		} else {
+			receivers[receiver.Name] = &c.Receivers[r]
+		}
+		for e, emailConfig := range receiver.EmailConfigs {
+			validateEmailConfig(&configErrors, fmt.Sprintf("%s.emailConfigs[%d]", receiverPath, e), emailConfig)
+		}
+		if integrations := unsupportedIntegrations(receiver); len(integrations) > 0 {
+			configErrors.add(receiverPath, "only email configs are supported, got %s", strings.Join(integrations, ", "))
+		}
+	}
+
+	if c.Route == nil {
+		configErrors.add(path+".route", "missing")
+	} else {
+		timeIntervalNames := map[string]bool{}
+		for _, timeInterval := range timeIntervals {
+			timeIntervalNames[timeInterval.Name] = true
+		}
+		validateRoute(&configErrors, path+".route", c.Route, receivers, timeIntervalNames)
+		if c.Tenant != "" {
+			validateTenantRoute(&configErrors, path+".route", c.Route, tenantLabel, c.Tenant)
+		}
+	}
+
+	return configErrors
+}
+
+// validateTenantRoute checks the tenant label matchers of the route and its child routes
+func validateTenantRoute(configErrors *ConfigErrors, path string, route *am_config.Route, tenantLabel string, tenant string) {
+	if value, has := route.Match[tenantLabel]; has && value != tenant {
+		configErrors.add(path+".match."+tenantLabel, "must not match other tenants, got %q", value)
+	}
+	if regexp, has := route.MatchRE[tenantLabel]; has && !regexp.MatchString(tenant) {
+		configErrors.add(path+".matchRe."+tenantLabel, "must not match other tenants, got %q", regexp.String())
+	}
+	for m, matcher := range route.Matchers {
+		if matcher.Name == tenantLabel && !matcher.Matches(tenant) {
+			configErrors.add(fmt.Sprintf("%s.matchers[%d]", path, m), "must not match other tenants, got %s", matcher)
+		}
+	}
+	for r, child := range route.Routes {
+		if child != nil {
+			validateTenantRoute(configErrors, fmt.Sprintf("%s.routes[%d]", path, r), child, tenantLabel, tenant)
+		}
+	}
+}
+
+func validateFlapDetection(configErrors *ConfigErrors, path string, flapDetection FlapDetectionConfig) {
+	if flapDetection.Threshold < 0 {
+		configErrors.add(path+".threshold", "must not be negative, got %d", flapDetection.Threshold)
+	}
+	if flapDetection.Threshold > 0 && flapDetection.WindowSec <= 0 {
+		configErrors.add(path+".windowSec", "must be positive, got %d", flapDetection.WindowSec)
+	}
+}
+
+func validateChangeDetection(configErrors *ConfigErrors, path string, changeDetection ChangeDetectionConfig) {
+	for l, label := range changeDetection.Labels {
+		if !prom_model.LabelName(label).IsValid() {
+			configErrors.add(fmt.Sprintf("%s.labels[%d]", path, l), "invalid label name %q", label)
+		}
+	}
+	for a, annotation := range changeDetection.Annotations {
+		if !prom_model.LabelName(annotation).IsValid() {
+			configErrors.add(fmt.Sprintf("%s.annotations[%d]", path, a), "invalid annotation name %q", annotation)
+		}
+	}
+	if changeDetection.MinIntervalSec < 0 {
+		configErrors.add(path+".minIntervalSec", "must not be negative, got %d", changeDetection.MinIntervalSec)
+	}
+}
+
+func validateRoute(configErrors *ConfigErrors, path string, route *am_config.Route, receivers map[string]*am_config.Receiver,
+	timeIntervals map[string]bool,
+) {
+	if route.Receiver != "" {
+		if _, has := receivers[route.Receiver]; !has {
+			configErrors.add(path+".receiver", "undefined receiver %q", route.Receiver)
+		}
+	}
+	if slices.Contains(route.GroupByStr, GroupByAll) && len(route.GroupByStr) > 1 {
+		configErrors.add(path+".groupByStr", "cannot have wildcard group_by (`...`) and other labels at the same time")
+	}
+	for _, label := range route.GroupByStr {
+		if label != GroupByAll && !prom_model.LabelName(label).IsValid() {
+			configErrors.add(path+".groupByStr", "invalid label name %q", label)
+		}
+	}
+	for i, name := range route.MuteTimeIntervals {
+		if !timeIntervals[name] {
+			configErrors.add(fmt.Sprintf("%s.muteTimeIntervals[%d]", path, i), "undefined time interval %q", name)
+		}
+	}
+	for i, name := range route.ActiveTimeIntervals {
+		if !timeIntervals[name] {
+			configErrors.add(fmt.Sprintf("%s.activeTimeIntervals[%d]", path, i), "undefined time interval %q", name)
+		}
+	}
+	for r, child := range route.Routes {
+		if child == nil {
+			configErrors.add(fmt.Sprintf("%s.routes[%d]", path, r), "empty route")
+
+			continue
+		}
+		validateRoute(configErrors, fmt.Sprintf("%s.routes[%d]", path, r), child, receivers, timeIntervals)
+	}
+}
+
+// unsupportedIntegrations returns the configured integrations of the receiver, which are not email
+func unsupportedIntegrations(receiver am_config.Receiver) []string {
+	integrations := []string{}
+	value := reflect.ValueOf(receiver)
+	for f := range value.NumField() {
+		field := value.Type().Field(f)
+		if field.Name != "EmailConfigs" && field.Type.Kind() == reflect.Slice && value.Field(f).Len() > 0 {
+			integrations = append(integrations, field.Name)
+		}
+	}
+
+	return integrations
+}
+
+func validateEmailConfig(configErrors *ConfigErrors, path string, emailConfig *am_config.EmailConfig) {
+	if emailConfig == nil {
+		configErrors.add(path, "empty email config")
+
+		return
+	}
+	if emailConfig.To == "" {
+		configErrors.add(path+".to", "missing")
+	}
+	if emailConfig.From == "" {
+		configErrors.add(path+".from", "missing")
+	}
+	if emailConfig.Smarthost.Host == "" || emailConfig.Smarthost.Port == "" {
+		configErrors.add(path+".smarthost", "host and port are required")
+	}
+	if emailConfig.AuthPassword != "" && emailConfig.AuthUsername == "" {
+		configErrors.add(path+".authUsername", "missing, but authPassword is set")
+	}
+}
+
+func validateUrl(configErrors *ConfigErrors, path string, value string) {
+	parsed, err := url.ParseRequestURI(value)
+	if err != nil {
+		configErrors.add(path, "%s", err)
+
+		return
+	}
+	if parsed.Scheme != "http" && parsed.Scheme != "https" {
+		configErrors.add(path, "unsupported scheme %q", parsed.Scheme)
+	} else if parsed.Host == "" {
+		configErrors.add(path, "missing host")
+	}
+}
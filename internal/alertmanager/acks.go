@@ -0,0 +1,351 @@
+package alertmanager
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"maps"
+	"net/http"
+	"os"
+	"slices"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/pgillich/micro-server/pkg/logger"
+
+	"github.com/pgillich/mimir-multitenant_alertmanager/configs"
+	api "github.com/pgillich/mimir-multitenant_alertmanager/pkg/api/alertmanager"
+)
+
+const (
+	ackFileMode = 0o600
+
+	// DefaultAckResolvePeriod is the default period of dropping the acknowledgements of the resolved alerts
+	DefaultAckResolvePeriod = time.Minute
+)
+
+var (
+	ErrAckStore, ErrAckStoreWrap = logger.WrapErr(errors.New("ack store error"))
+	ErrInvalidAck                = errors.New("invalid acknowledgement")
+	ErrAckNotFound               = errors.New("acknowledgement not found")
+)
+
+// AckStore keeps the acknowledgements of the alerts by multi-tenant fingerprint.
+// The acknowledgements are saved to a JSON file on every change, so they survive a restart.
+// The expired acknowledgements and the acknowledgements of the resolved alerts are dropped, see Resolve.
+type AckStore struct {
+	mu   sync.Mutex
+	path string
+	now  func() time.Time
+	acks map[string]api.Ack
+}
+
+// NewAckStore loads the acknowledgement file, the acknowledgements are kept in memory only, if the path is empty
+func NewAckStore(path string, now func() time.Time) (*AckStore, error) {
+	a := &AckStore{
+		path: path,
+		now:  now,
+		acks: map[string]api.Ack{},
+	}
+	if path == "" {
+		return a, nil
+	}
+
+	content, err := os.ReadFile(path) //nolint:gosec // file given by the config
+	if errors.Is(err, os.ErrNotExist) {
+		return a, nil
+	} else if err != nil {
+		return nil, ErrAckStoreWrap(err)
+	}
+	acks := []api.Ack{}
+	if err := json.Unmarshal(content, &acks); err != nil {
+		return nil, ErrAckStoreWrap(err)
+	}
+	for _, ack := range acks {
+		a.acks[ack.Fingerprint] = ack
+	}
+
+	return a, nil
+}
+
+// Ack acknowledges the alert, the previous acknowledgement of the alert is overwritten
+func (a *AckStore) Ack(fingerprint string, postableAck api.PostableAck) (api.Ack, error) {
+	fingerprint, err := normalizeFingerprint(fingerprint)
+	if err != nil {
+		return api.Ack{}, logger.Wrap(ErrInvalidAck, err)
+	}
+	if postableAck.User == "" {
+		return api.Ack{}, logger.Wrap(ErrInvalidAck, errors.New("missing user"))
+	}
+	now := a.now()
+	if postableAck.ExpiresAt != nil && !postableAck.ExpiresAt.After(now) {
+		return api.Ack{}, logger.Wrap(ErrInvalidAck, errors.New("expiresAt is in the past"))
+	}
+	ack := api.Ack{
+		Fingerprint: fingerprint,
+		User:        postableAck.User,
+		AckedAt:     now,
+		ExpiresAt:   postableAck.ExpiresAt,
+	}
+	if postableAck.Comment != nil {
+		ack.Comment = *postableAck.Comment
+	}
+
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	previous := maps.Clone(a.acks)
+	a.acks[fingerprint] = ack
+	if err := a.save(now); err != nil {
+		a.acks = previous
+		return api.Ack{}, err
+	}
+
+	return ack, nil
+}
+
+// Unack removes the acknowledgement of the alert
+func (a *AckStore) Unack(fingerprint string) error {
+	fingerprint, err := normalizeFingerprint(fingerprint)
+	if err != nil {
+		return logger.Wrap(ErrAckNotFound, err)
+	}
+
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	now := a.now()
+	if ack, has := a.acks[fingerprint]; !has || ackExpired(ack, now) {
+		return ErrAckNotFound
+	}
+	previous := maps.Clone(a.acks)
+	delete(a.acks, fingerprint)
+	if err := a.save(now); err != nil {
+		a.acks = previous
+		return err
+	}
+
+	return nil
+}
+
+// runAckResolver drops the acknowledgements of the resolved alerts periodically, see resolveAcks
+func (s *HttpService) runAckResolver(ctx context.Context, shutdown chan struct{}) {
+	_, log := logger.FromContext(ctx, "goroutine", "AckResolver")
+	period := DefaultAckResolvePeriod
+	if s.serverConfig.Alerts.AckResolvePeriodSec > 0 {
+		period = time.Duration(s.serverConfig.Alerts.AckResolvePeriodSec) * time.Second
+	}
+	go func() {
+		ticker := time.NewTicker(period)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-shutdown:
+				log.Info("Shutdown")
+				return
+			case <-ctx.Done():
+				log.Info("ctx.Done")
+				return
+			case <-ticker.C:
+				if err := s.resolveAcks(ctx); err != nil {
+					log.Warn("Unable to drop the acknowledgements of the resolved alerts", logger.KeyError, err)
+				}
+			}
+		}
+	}()
+}
+
+// resolveAcks gets the alerts of all tenants and drops the acknowledgements of the resolved alerts.
+// The acknowledgements are kept, if a tenant can't be fetched, because the alert list is not complete.
+func (s *HttpService) resolveAcks(ctx context.Context) error {
+	_, log := logger.FromContext(ctx)
+	alerts := []api.GettableAlert{}
+	for _, tenant := range s.serverConfig.Alerts.Tenants {
+		upstreamAlerts, err := s.apiServer.getUpstreamAlerts(ctx, tenant, &api.GetAlertsParams{})
+		if err != nil {
+			return err
+		}
+		for _, alert := range upstreamAlerts {
+			s.apiServer.tenantAlert(log, tenant, &alert)
+			alerts = append(alerts, alert)
+		}
+	}
+
+	return s.acks.Resolve(alerts)
+}
+
+// Resolve drops the acknowledgements of the resolved alerts. The alerts must be the complete list of the alerts,
+// an acknowledged alert is resolved, if it's missing from the list, or it's started again after the acknowledgement.
+func (a *AckStore) Resolve(alerts []api.GettableAlert) error {
+	firing := map[string]api.GettableAlert{}
+	for _, alert := range alerts {
+		if fingerprint, err := normalizeFingerprint(alert.Fingerprint); err == nil {
+			firing[fingerprint] = alert
+		}
+	}
+
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	previous := maps.Clone(a.acks)
+	maps.DeleteFunc(a.acks, func(fingerprint string, ack api.Ack) bool {
+		alert, has := firing[fingerprint]
+		return !has || ackStale(ack, alert)
+	})
+	if len(a.acks) == len(previous) {
+		return nil
+	}
+	if err := a.save(a.now()); err != nil {
+		a.acks = previous
+		return err
+	}
+
+	return nil
+}
+
+// Get returns the acknowledgement of the alert, if it's not expired
+func (a *AckStore) Get(fingerprint string) (api.Ack, bool) {
+	fingerprint, err := normalizeFingerprint(fingerprint)
+	if err != nil {
+		return api.Ack{}, false
+	}
+
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	ack, has := a.acks[fingerprint]
+	if !has || ackExpired(ack, a.now()) {
+		return api.Ack{}, false
+	}
+
+	return ack, true
+}
+
+// List returns the acknowledgements, which are not expired, ordered by fingerprint
+func (a *AckStore) List() []api.Ack {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	now := a.now()
+	acks := []api.Ack{}
+	for _, fingerprint := range slices.Sorted(maps.Keys(a.acks)) {
+		if ack := a.acks[fingerprint]; !ackExpired(ack, now) {
+			acks = append(acks, ack)
+		}
+	}
+
+	return acks
+}
+
+// Annotate sets the acknowledgement annotations of the alert, if it's not started again after the acknowledgement
+func (a *AckStore) Annotate(alert *api.GettableAlert) {
+	if ack, has := a.Get(alert.Fingerprint); has && !ackStale(ack, *alert) {
+		alert.Annotations[configs.AckedByAnnotation] = ack.User
+		alert.Annotations[configs.AckedAtAnnotation] = ack.AckedAt.Format(time.RFC3339)
+	}
+}
+
+// save drops the expired acknowledgements and rewrites the file, the lock must be held
+func (a *AckStore) save(now time.Time) error {
+	maps.DeleteFunc(a.acks, func(_ string, ack api.Ack) bool {
+		return ackExpired(ack, now)
+	})
+	if a.path == "" {
+		return nil
+	}
+	acks := make([]api.Ack, 0, len(a.acks))
+	for _, fingerprint := range slices.Sorted(maps.Keys(a.acks)) {
+		acks = append(acks, a.acks[fingerprint])
+	}
+	content, err := json.Marshal(acks)
+	if err != nil {
+		return ErrAckStoreWrap(err)
+	}
+	tmpPath := a.path + ".tmp"
+	if err := os.WriteFile(tmpPath, content, ackFileMode); err != nil {
+		return ErrAckStoreWrap(err)
+	}
+	if err := os.Rename(tmpPath, a.path); err != nil {
+		return ErrAckStoreWrap(err)
+	}
+
+	return nil
+}
+
+func ackExpired(ack api.Ack, now time.Time) bool {
+	return ack.ExpiresAt != nil && !ack.ExpiresAt.After(now)
+}
+
+// ackStale checks, if the acknowledgement belongs to a previous firing of the alert
+func ackStale(ack api.Ack, alert api.GettableAlert) bool {
+	return alert.StartsAt.After(ack.AckedAt)
+}
+
+// normalizeFingerprint returns the non-padded form of the fingerprint, see EqualFingerprint
+func normalizeFingerprint(fingerprint string) (string, error) {
+	value, err := strconv.ParseUint(fingerprint, 16, 64)
+	if err != nil {
+		return "", err
+	}
+
+	return strconv.FormatUint(value, 16), nil
+}
+
+func (s *ApiServer) PostAck(w http.ResponseWriter, r *http.Request, fingerprint string) {
+	_, log := logger.FromContext(r.Context(), "fingerprint", fingerprint)
+	var body api.PostableAck
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		log.Warn("Unable to PostAck", logger.KeyError, err)
+		if err = api.PostAck400JSONResponse(logger.Wrap(ErrInvalidAck, err).Error()).VisitPostAckResponse(w); err != nil {
+			log.Error("Unable to render error response", logger.KeyError, ErrRenderResponseWrap(err))
+		}
+		return
+	}
+
+	ack, err := s.service.acks.Ack(fingerprint, body)
+	switch {
+	case errors.Is(err, ErrInvalidAck):
+		log.Warn("Unable to PostAck", logger.KeyError, err)
+		if err = api.PostAck400JSONResponse(err.Error()).VisitPostAckResponse(w); err != nil {
+			log.Error("Unable to render error response", logger.KeyError, ErrRenderResponseWrap(err))
+		}
+		return
+	case err != nil:
+		log.Error("Unable to PostAck", logger.KeyError, err)
+		if err = api.PostAck500JSONResponse(err.Error()).VisitPostAckResponse(w); err != nil {
+			log.Error("Unable to render error response", logger.KeyError, ErrRenderResponseWrap(err))
+		}
+		return
+	}
+	log.Info("Alert acknowledged", "user", ack.User, "expiresAt", ack.ExpiresAt)
+
+	if err := api.PostAck200JSONResponse(ack).VisitPostAckResponse(w); err != nil {
+		log.Error("Unable to render response", logger.KeyError, ErrRenderResponseWrap(err))
+	}
+}
+
+func (s *ApiServer) DeleteAck(w http.ResponseWriter, r *http.Request, fingerprint string) {
+	_, log := logger.FromContext(r.Context(), "fingerprint", fingerprint)
+	err := s.service.acks.Unack(fingerprint)
+	switch {
+	case errors.Is(err, ErrAckNotFound):
+		if err = api.DeleteAck404JSONResponse(err.Error()).VisitDeleteAckResponse(w); err != nil {
+			log.Error("Unable to render error response", logger.KeyError, ErrRenderResponseWrap(err))
+		}
+		return
+	case err != nil:
+		log.Error("Unable to DeleteAck", logger.KeyError, err)
+		if err = api.DeleteAck500JSONResponse(err.Error()).VisitDeleteAckResponse(w); err != nil {
+			log.Error("Unable to render error response", logger.KeyError, ErrRenderResponseWrap(err))
+		}
+		return
+	}
+	log.Info("Alert acknowledgement removed")
+
+	if err := (api.DeleteAck200Response{}).VisitDeleteAckResponse(w); err != nil {
+		log.Error("Unable to render response", logger.KeyError, ErrRenderResponseWrap(err))
+	}
+}
+
+func (s *ApiServer) GetAcks(w http.ResponseWriter, r *http.Request) {
+	_, log := logger.FromContext(r.Context())
+	if err := api.GetAcks200JSONResponse(s.service.acks.List()).VisitGetAcksResponse(w); err != nil {
+		log.Error("Unable to render response", logger.KeyError, ErrRenderResponseWrap(err))
+	}
+}
@@ -0,0 +1,241 @@
+package alertmanager
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	html_tmpl "html/template"
+	"log/slog"
+	"maps"
+	"net/http"
+	"net/url"
+	"slices"
+	"time"
+
+	prom_model "github.com/prometheus/common/model"
+
+	"github.com/pgillich/micro-server/pkg/logger"
+
+	"github.com/pgillich/mimir-multitenant_alertmanager/configs"
+	"github.com/pgillich/mimir-multitenant_alertmanager/internal/actionlink"
+	api "github.com/pgillich/mimir-multitenant_alertmanager/pkg/api/alertmanager"
+)
+
+// actionViewPath is the web UI page of an alert, relative to the action endpoint
+const actionViewPath = "../../../../" + configs.ServiceNameWebUI + "/alert.html"
+
+var (
+	ErrInvalidAction = errors.New("invalid action")
+
+	// actionConfirmTemplate is the confirmation page of an action link, the form posts the same link
+	actionConfirmTemplate = html_tmpl.Must(html_tmpl.New("action").Parse(`<!DOCTYPE html>
+<html>
+<head><meta charset="utf-8"><meta name="robots" content="noindex"><title>Confirm {{ .Action }}</title></head>
+<body>
+<p>{{ if eq .Action "silence" }}Silence the alert {{ .Fingerprint }} of tenant {{ .Tenant }} for {{ .Duration }}?
+{{- else }}Acknowledge the alert {{ .Fingerprint }} of tenant {{ .Tenant }}?{{ end }}</p>
+<form method="post"><button type="submit">Confirm</button></form>
+</body>
+</html>
+`))
+)
+
+// GetAction verifies the signed action link and shows the confirmation page of the action.
+// The action isn't performed on GET, so a link scanner of a mail server can't perform it, see PostAction.
+func (s *ApiServer) GetAction(w http.ResponseWriter, r *http.Request, action api.GetActionParamsAction, params api.GetActionParams) {
+	_, log := logger.FromContext(r.Context(), "action", action, "tenant", params.Tenant, "fingerprint", params.Fingerprint)
+	link := newActionLink(string(action), params.Tenant, params.Fingerprint, params.Duration, params.Expires)
+	if err := actionlink.Verify(s.service.serverConfig.Alerts.ActionLinkSecret, link, params.Signature, time.Now()); err != nil {
+		log.Warn("Unable to verify action link", logger.KeyError, err)
+		if err = api.GetAction403JSONResponse(err.Error()).VisitGetActionResponse(w); err != nil {
+			log.Error("Unable to render error response", logger.KeyError, ErrRenderResponseWrap(err))
+		}
+		return
+	}
+
+	switch action {
+	case api.GetActionParamsActionView:
+		http.Redirect(w, r, actionViewPath+"?"+url.Values{"fingerprint": []string{link.Fingerprint}}.Encode(), http.StatusSeeOther)
+		return
+	case api.GetActionParamsActionAck, api.GetActionParamsActionSilence:
+	default:
+		log.Warn("Unable to show action", logger.KeyError, ErrInvalidAction)
+		if err := api.GetAction400JSONResponse(ErrInvalidAction.Error()).VisitGetActionResponse(w); err != nil {
+			log.Error("Unable to render error response", logger.KeyError, ErrRenderResponseWrap(err))
+		}
+		return
+	}
+
+	page := &bytes.Buffer{}
+	if err := actionConfirmTemplate.Execute(page, link); err != nil {
+		log.Error("Unable to render action page", logger.KeyError, err)
+		if err = api.GetAction500JSONResponse(err.Error()).VisitGetActionResponse(w); err != nil {
+			log.Error("Unable to render error response", logger.KeyError, ErrRenderResponseWrap(err))
+		}
+		return
+	}
+	if err := (api.GetAction200TexthtmlResponse{Body: page, ContentLength: int64(page.Len())}).VisitGetActionResponse(w); err != nil {
+		log.Error("Unable to render response", logger.KeyError, ErrRenderResponseWrap(err))
+	}
+}
+
+// PostAction verifies the signed action link and performs the action against the tenant of the alert.
+// The action is idempotent: an existing acknowledgement or action link silence of the alert is kept and returned.
+func (s *ApiServer) PostAction(w http.ResponseWriter, r *http.Request, action api.PostActionParamsAction, params api.PostActionParams) {
+	_, log := logger.FromContext(r.Context(), "action", action, "tenant", params.Tenant, "fingerprint", params.Fingerprint)
+	link := newActionLink(string(action), params.Tenant, params.Fingerprint, params.Duration, params.Expires)
+	if err := actionlink.Verify(s.service.serverConfig.Alerts.ActionLinkSecret, link, params.Signature, time.Now()); err != nil {
+		log.Warn("Unable to verify action link", logger.KeyError, err)
+		if err = api.PostAction403JSONResponse(err.Error()).VisitPostActionResponse(w); err != nil {
+			log.Error("Unable to render error response", logger.KeyError, ErrRenderResponseWrap(err))
+		}
+		return
+	}
+
+	result := api.ActionResult{Action: link.Action, Tenant: link.Tenant, Fingerprint: link.Fingerprint}
+	switch action {
+	case api.PostActionParamsActionAck:
+		if _, acked := s.service.acks.Get(link.Fingerprint); !acked {
+			comment := "acknowledged by action link"
+			if _, err := s.service.acks.Ack(link.Fingerprint, api.PostableAck{User: actionlink.User, Comment: &comment}); err != nil {
+				s.renderActionError(w, log, err)
+				return
+			}
+		}
+		result.Message = "alert acknowledged"
+	case api.PostActionParamsActionSilence:
+		silenceID, err := s.silenceAlert(r.Context(), log, link)
+		if err != nil {
+			s.renderActionError(w, log, err)
+			return
+		}
+		result.SilenceID = &silenceID
+		result.Message = "alert silenced for " + link.Duration
+	default:
+		s.renderActionError(w, log, ErrInvalidAction)
+		return
+	}
+	log.Info("Action performed", "message", result.Message)
+
+	if err := api.PostAction200JSONResponse(result).VisitPostActionResponse(w); err != nil {
+		log.Error("Unable to render response", logger.KeyError, ErrRenderResponseWrap(err))
+	}
+}
+
+// newActionLink returns the signed content of the action link from the request parameters
+func newActionLink(action string, tenant string, fingerprint string, duration *string, expires int64) actionlink.Link {
+	link := actionlink.Link{
+		Action:      action,
+		Tenant:      tenant,
+		Fingerprint: fingerprint,
+		Expires:     time.Unix(expires, 0),
+	}
+	if duration != nil {
+		link.Duration = *duration
+	}
+
+	return link
+}
+
+// silenceAlert silences the alert by its labels in its tenant for the duration of the link.
+// If the alert is already silenced by an action link with the same matchers, the existing silence is returned.
+func (s *ApiServer) silenceAlert(ctx context.Context, log *slog.Logger, link actionlink.Link) (string, error) {
+	duration, err := prom_model.ParseDuration(link.Duration)
+	if err != nil || duration <= 0 {
+		return "", logger.Wrap(ErrInvalidAction, errors.New("invalid duration "+link.Duration))
+	}
+	if !slices.Contains(s.service.serverConfig.Alerts.Tenants, link.Tenant) {
+		return "", ErrUnknownTenantWrap(errors.New(link.Tenant))
+	}
+	upstreamAlerts, err := s.getUpstreamAlerts(ctx, link.Tenant, &api.GetAlertsParams{})
+	if err != nil {
+		return "", err
+	}
+
+	for _, alert := range upstreamAlerts {
+		labels := maps.Clone(alert.Labels)
+		s.tenantAlert(log, link.Tenant, &alert)
+		if !EqualFingerprint(alert.Fingerprint, link.Fingerprint) {
+			continue
+		}
+		equal := true
+		silence := api.PostableSilence{
+			Matchers:  api.Matchers{},
+			StartsAt:  time.Now(),
+			EndsAt:    time.Now().Add(time.Duration(duration)),
+			CreatedBy: actionlink.User,
+			Comment:   "silenced by action link",
+		}
+		for _, name := range slices.Sorted(maps.Keys(labels)) {
+			silence.Matchers = append(silence.Matchers, api.Matcher{Name: name, Value: labels[name], IsEqual: &equal})
+		}
+		silenceID, err := s.findActionSilence(ctx, link.Tenant, silence.Matchers)
+		if err != nil || silenceID != "" {
+			return silenceID, err
+		}
+
+		return s.postSilence(ctx, link.Tenant, silence)
+	}
+
+	return "", ErrAlertNotFound
+}
+
+// findActionSilence returns the ID of the not expired action link silence of the tenant with the matchers.
+// An empty ID is returned, if there is no such silence.
+func (s *ApiServer) findActionSilence(ctx context.Context, tenant string, matchers api.Matchers) (string, error) {
+	mimirResp, err := s.service.mimirClient.GetSilencesWithResponse(
+		ctx, &api.GetSilencesParams{}, RequestHeaderSet(configs.HttpHeaderXscopeorgid, tenant),
+	)
+	if err != nil {
+		return "", ErrMimirResponseWrap(err)
+	}
+	if mimirResp.HTTPResponse.StatusCode != http.StatusOK || mimirResp.JSON200 == nil {
+		return "", ErrInvalidResponseStatusWrap(errors.New(mimirResp.HTTPResponse.Status))
+	}
+
+	for _, silence := range *mimirResp.JSON200 {
+		if silence.CreatedBy == actionlink.User && silence.Status.State != api.SilenceStatusStateExpired &&
+			equalMatchers(silence.Matchers, matchers) {
+			return silence.Id, nil
+		}
+	}
+
+	return "", nil
+}
+
+// equalMatchers compares the matchers regardless of their order, a missing isEqual means equal
+func equalMatchers(matchers api.Matchers, others api.Matchers) bool {
+	key := func(matcher api.Matcher) string {
+		return fmt.Sprintf("%s|%t|%t|%s", matcher.Name, matcher.IsEqual == nil || *matcher.IsEqual, matcher.IsRegex, matcher.Value)
+	}
+	keys := func(matchers api.Matchers) []string {
+		keys := make([]string, 0, len(matchers))
+		for _, matcher := range matchers {
+			keys = append(keys, key(matcher))
+		}
+		slices.Sort(keys)
+
+		return keys
+	}
+
+	return slices.Equal(keys(matchers), keys(others))
+}
+
+func (s *ApiServer) renderActionError(w http.ResponseWriter, log *slog.Logger, err error) {
+	var response api.PostActionResponseObject
+	switch {
+	case errors.Is(err, ErrInvalidAction), errors.Is(err, ErrInvalidAck):
+		log.Warn("Unable to perform action", logger.KeyError, err)
+		response = api.PostAction400JSONResponse(err.Error())
+	case errors.Is(err, ErrAlertNotFound), errors.Is(err, ErrUnknownTenant):
+		log.Warn("Unable to perform action", logger.KeyError, err)
+		response = api.PostAction404JSONResponse(err.Error())
+	default:
+		log.Error("Unable to perform action", logger.KeyError, err)
+		response = api.PostAction500JSONResponse(err.Error())
+	}
+	if err = response.VisitPostActionResponse(w); err != nil {
+		log.Error("Unable to render error response", logger.KeyError, ErrRenderResponseWrap(err))
+	}
+}
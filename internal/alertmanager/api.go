@@ -2,9 +2,14 @@ package alertmanager
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
+	"fmt"
+	"log/slog"
 	"net/http"
+	"slices"
 	"strconv"
+	"strings"
 
 	prom_model "github.com/prometheus/common/model"
 
@@ -22,6 +27,9 @@ var (
 	ErrMimirResponse, ErrMimirResponseWrap                 = logger.WrapErr(errors.New("mimir response"))
 	ErrInvalidResponseStatus, ErrInvalidResponseStatusWrap = logger.WrapErr(errors.New("invalid response status"))
 	ErrRenderResponse, ErrRenderResponseWrap               = logger.WrapErr(errors.New("unable to render response"))
+	ErrAlertNotFound                                       = errors.New("alert not found")
+	ErrFailedTenants, ErrFailedTenantsWrap                 = logger.WrapErr(errors.New("failed tenants"))
+	ErrInvalidSilence                                      = errors.New("invalid silence")
 )
 
 func RequestHeaderSet(headerKey, headerValue string) func(ctx context.Context, req *http.Request) error {
@@ -31,48 +39,187 @@ func RequestHeaderSet(headerKey, headerValue string) func(ctx context.Context, r
 	}
 }
 
+// SetTenantMeta sets the configured metadata of the tenant on the labels and annotations of an alert.
+// The existing labels and annotations are kept with a warning, unless TenantMeta.Override is set.
+func SetTenantMeta(log *slog.Logger, alertsConfig *configs.AlertsConfig, tenant string, labels api.LabelSet, annotations api.LabelSet) {
+	if alertsConfig.TenantMeta == nil {
+		return
+	}
+	setTenantMeta(log, alertsConfig, tenant, "label", alertsConfig.TenantMeta.Labels, labels)
+	setTenantMeta(log, alertsConfig, tenant, "annotation", alertsConfig.TenantMeta.Annotations, annotations)
+}
+
+func setTenantMeta(log *slog.Logger, alertsConfig *configs.AlertsConfig, tenant string, kind string, names map[string]string,
+	kv api.LabelSet,
+) {
+	for key, name := range names {
+		value := alertsConfig.GetTenantMeta(tenant, key)
+		if value == "" {
+			continue
+		}
+		if existing, has := kv[name]; has && existing != value && !alertsConfig.TenantMeta.Override {
+			log.Warn("Tenant metadata is not set, the "+kind+" exists", "tenant", tenant, "name", name, "value", existing)
+
+			continue
+		}
+		kv[name] = value
+	}
+}
+
+// tenantAlert converts the alert of a tenant to the multi-tenant view and returns the upstream fingerprint.
+// The acknowledgement of the alert is set as annotations.
+func (s *ApiServer) tenantAlert(log *slog.Logger, tenant string, alert *api.GettableAlert) string {
+	upstreamFingerprint := alert.Fingerprint
+	mustFingerprint := strconv.FormatUint(prom_model.LabelsToSignature(alert.Labels), 16)
+	if alert.Fingerprint != mustFingerprint {
+		log.Debug("Fingerprint mismatch", "alertFingerprint", alert.Fingerprint, "mustFingerprint", mustFingerprint)
+	}
+	alert.Annotations[s.service.serverConfig.Alerts.TenantLabel] = tenant
+	alert.Labels[s.service.serverConfig.Alerts.TenantLabel] = tenant
+	SetTenantMeta(log, s.service.serverConfig.Alerts, tenant, alert.Labels, alert.Annotations)
+	alert.Fingerprint = strconv.FormatUint(prom_model.LabelsToSignature(alert.Labels), 16)
+	for r := range alert.Receivers {
+		alert.Receivers[r].Name = tenant + "/" + alert.Receivers[r].Name
+	}
+	s.service.acks.Annotate(alert)
+
+	return upstreamFingerprint
+}
+
+// EqualFingerprint compares two fingerprints by value, so zero-padded and non-padded forms are equal
+func EqualFingerprint(fingerprint1, fingerprint2 string) bool {
+	value1, err1 := strconv.ParseUint(fingerprint1, 16, 64)
+	value2, err2 := strconv.ParseUint(fingerprint2, 16, 64)
+	if err1 != nil || err2 != nil {
+		return fingerprint1 == fingerprint2
+	}
+
+	return value1 == value2
+}
+
+// GetAlerts gets the alerts of all tenants.
+// The tenants, which can't be fetched, are skipped and listed in the failed tenants header,
+// if a partial response is requested and at least one tenant is fetched.
 func (s *ApiServer) GetAlerts(w http.ResponseWriter, r *http.Request, params api.GetAlertsParams) {
 	_, log := logger.FromContext(r.Context(), "alertmanagerUrl", s.service.serverConfig.Alerts.AlertmanagerUrl)
+	partial := r.Header.Get(configs.HttpHeaderPartialResponse) == "true"
 	alerts := []api.GettableAlert{}
+	failedTenants := []string{}
+	var tenantErr error
 
 	for _, tenant := range s.service.serverConfig.Alerts.Tenants {
-		mimirResp, err := s.service.mimirClient.GetAlertsWithResponse(
-			r.Context(), &params, RequestHeaderSet(configs.HttpHeaderXscopeorgid, tenant),
-		)
+		upstreamAlerts, err := s.getUpstreamAlerts(r.Context(), tenant, &params)
+		if err != nil && partial {
+			log.Warn("Unable to GetAlerts of tenant", "tenant", tenant, logger.KeyError, err)
+			failedTenants = append(failedTenants, tenant)
+			tenantErr = err
+
+			continue
+		}
 		if err != nil {
-			err = ErrMimirResponseWrap(err)
 			log.Error("Unable to GetAlerts", logger.KeyError, err)
 			if err = api.GetAlerts500JSONResponse(err.Error()).VisitGetAlertsResponse(w); err != nil {
 				log.Error("Unable to render error response", logger.KeyError, ErrRenderResponseWrap(err))
 			}
 			return
 		}
-		if mimirResp.HTTPResponse.StatusCode != http.StatusOK || mimirResp.JSON200 == nil {
-			err = ErrInvalidResponseStatusWrap(errors.New(mimirResp.HTTPResponse.Status))
-			log.Error("Unable to GetAlerts", logger.KeyError, err)
-			if err = api.GetAlerts500JSONResponse(err.Error()).VisitGetAlertsResponse(w); err != nil {
+
+		for _, alert := range upstreamAlerts {
+			s.tenantAlert(log, tenant, &alert)
+			alerts = append(alerts, alert)
+		}
+	}
+
+	if len(failedTenants) > 0 {
+		if len(failedTenants) == len(s.service.serverConfig.Alerts.Tenants) {
+			log.Error("Unable to GetAlerts of any tenant", logger.KeyError, tenantErr)
+			if err := api.GetAlerts500JSONResponse(tenantErr.Error()).VisitGetAlertsResponse(w); err != nil {
 				log.Error("Unable to render error response", logger.KeyError, ErrRenderResponseWrap(err))
 			}
 			return
 		}
+		w.Header().Set(configs.HttpHeaderFailedTenants, strings.Join(failedTenants, ","))
+	}
+
+	if err := api.GetAlerts200JSONResponse(alerts).VisitGetAlertsResponse(w); err != nil {
+		log.Error("Unable to render response", logger.KeyError, ErrRenderResponseWrap(err))
+	}
+}
+
+// getUpstreamAlerts gets the alerts of a tenant from Mimir, without converting them to the multi-tenant view
+func (s *ApiServer) getUpstreamAlerts(ctx context.Context, tenant string, params *api.GetAlertsParams) (api.GettableAlerts, error) {
+	mimirResp, err := s.service.mimirClient.GetAlertsWithResponse(
+		ctx, params, RequestHeaderSet(configs.HttpHeaderXscopeorgid, tenant),
+	)
+	if err != nil {
+		return nil, ErrMimirResponseWrap(err)
+	}
+	if mimirResp.HTTPResponse.StatusCode != http.StatusOK || mimirResp.JSON200 == nil {
+		return nil, ErrInvalidResponseStatusWrap(errors.New(mimirResp.HTTPResponse.Status))
+	}
+
+	return *mimirResp.JSON200, nil
+}
 
-		for _, alert := range *mimirResp.JSON200 {
-			mustFingerprint := strconv.FormatUint(prom_model.LabelsToSignature(alert.Labels), 16)
-			if alert.Fingerprint != mustFingerprint {
-				log.Debug("Fingerprint mismatch", "alertFingerprint", alert.Fingerprint, "mustFingerprint", mustFingerprint)
+// GetTenantAlert looks up the alert in the given tenant, or in all tenants.
+// Without a given tenant, the failed tenants are skipped. If the alert is not found and a tenant failed,
+// the error names the failed tenants, which are set in the X-Failed-Tenants header, too.
+func (s *ApiServer) GetTenantAlert(w http.ResponseWriter, r *http.Request, fingerprint string, params api.GetTenantAlertParams) {
+	_, log := logger.FromContext(r.Context(), "alertmanagerUrl", s.service.serverConfig.Alerts.AlertmanagerUrl, "fingerprint", fingerprint)
+	tenants := s.service.serverConfig.Alerts.Tenants
+	if params.Tenant != nil {
+		if !slices.Contains(tenants, *params.Tenant) {
+			err := ErrUnknownTenantWrap(errors.New(*params.Tenant))
+			log.Warn("Unable to GetTenantAlert", logger.KeyError, err)
+			if err = api.GetTenantAlert400JSONResponse(err.Error()).VisitGetTenantAlertResponse(w); err != nil {
+				log.Error("Unable to render error response", logger.KeyError, ErrRenderResponseWrap(err))
 			}
-			alert.Annotations[s.service.serverConfig.Alerts.TenantLabel] = tenant
-			alert.Labels[s.service.serverConfig.Alerts.TenantLabel] = tenant
-			alert.Fingerprint = strconv.FormatUint(prom_model.LabelsToSignature(alert.Labels), 16)
-			for r := range alert.Receivers {
-				alert.Receivers[r].Name = tenant + "/" + alert.Receivers[r].Name
+			return
+		}
+		tenants = []string{*params.Tenant}
+	}
+
+	failedTenants := []string{}
+	var errs []error
+	for _, tenant := range tenants {
+		upstreamAlerts, err := s.getUpstreamAlerts(r.Context(), tenant, &api.GetAlertsParams{})
+		if err != nil {
+			log.Warn("Unable to GetTenantAlert, tenant skipped", "tenant", tenant, logger.KeyError, err)
+			failedTenants = append(failedTenants, tenant)
+			errs = append(errs, err)
+			continue
+		}
+
+		for _, alert := range upstreamAlerts {
+			upstreamFingerprint := s.tenantAlert(log, tenant, &alert)
+			if EqualFingerprint(alert.Fingerprint, fingerprint) ||
+				(params.Tenant != nil && EqualFingerprint(upstreamFingerprint, fingerprint)) {
+				if err := api.GetTenantAlert200JSONResponse(api.TenantAlert{
+					Alert:               alert,
+					Tenant:              tenant,
+					Fingerprint:         alert.Fingerprint,
+					UpstreamFingerprint: upstreamFingerprint,
+				}).VisitGetTenantAlertResponse(w); err != nil {
+					log.Error("Unable to render response", logger.KeyError, ErrRenderResponseWrap(err))
+				}
+				return
 			}
-			alerts = append(alerts, alert)
 		}
 	}
 
-	if err := api.GetAlerts200JSONResponse(alerts).VisitGetAlertsResponse(w); err != nil {
-		log.Error("Unable to render response", logger.KeyError, ErrRenderResponseWrap(err))
+	// The alert may belong to a failed tenant, so it's not reported as not found
+	if len(failedTenants) > 0 {
+		w.Header().Set(configs.HttpHeaderFailedTenants, strings.Join(failedTenants, ","))
+		err := ErrFailedTenantsWrap(fmt.Errorf("%s: %w", strings.Join(failedTenants, ","), errors.Join(errs...)))
+		log.Error("Unable to GetTenantAlert", logger.KeyError, err)
+		if err = api.GetTenantAlert500JSONResponse(err.Error()).VisitGetTenantAlertResponse(w); err != nil {
+			log.Error("Unable to render error response", logger.KeyError, ErrRenderResponseWrap(err))
+		}
+		return
+	}
+
+	if err := api.GetTenantAlert404JSONResponse(ErrAlertNotFound.Error()).VisitGetTenantAlertResponse(w); err != nil {
+		log.Error("Unable to render error response", logger.KeyError, ErrRenderResponseWrap(err))
 	}
 }
 
@@ -104,17 +251,7 @@ func (s *ApiServer) GetAlertGroups(w http.ResponseWriter, r *http.Request, param
 		for _, alertGroup := range *mimirResp.JSON200 {
 			alertGroup.Labels[s.service.serverConfig.Alerts.TenantLabel] = tenant
 			for a := range alertGroup.Alerts {
-				alert := &alertGroup.Alerts[a]
-				mustFingerprint := strconv.FormatUint(prom_model.LabelsToSignature(alert.Labels), 16)
-				if alert.Fingerprint != mustFingerprint {
-					log.Debug("Fingerprint mismatch", "alertFingerprint", alert.Fingerprint, "mustFingerprint", mustFingerprint)
-				}
-				alert.Annotations[s.service.serverConfig.Alerts.TenantLabel] = tenant
-				alert.Labels[s.service.serverConfig.Alerts.TenantLabel] = tenant
-				alert.Fingerprint = strconv.FormatUint(prom_model.LabelsToSignature(alert.Labels), 16)
-				for r := range alert.Receivers {
-					alert.Receivers[r].Name = tenant + "/" + alert.Receivers[r].Name
-				}
+				s.tenantAlert(log, tenant, &alertGroup.Alerts[a])
 			}
 			alertGroup.Receiver.Name = tenant + "/" + alertGroup.Receiver.Name
 
@@ -168,3 +305,62 @@ func (s *ApiServer) GetSilences(w http.ResponseWriter, r *http.Request, params a
 		log.Error("Unable to render response", logger.KeyError, ErrRenderResponseWrap(err))
 	}
 }
+
+// PostSilences creates a silence in the tenant selected by the tenant matcher, the tenant matcher is not forwarded
+func (s *ApiServer) PostSilences(w http.ResponseWriter, r *http.Request) {
+	_, log := logger.FromContext(r.Context(), "alertmanagerUrl", s.service.serverConfig.Alerts.AlertmanagerUrl)
+	var silence api.PostableSilence
+	if err := json.NewDecoder(r.Body).Decode(&silence); err != nil {
+		log.Warn("Unable to PostSilences", logger.KeyError, err)
+		if err = api.PostSilences400JSONResponse(logger.Wrap(ErrInvalidSilence, err).Error()).VisitPostSilencesResponse(w); err != nil {
+			log.Error("Unable to render error response", logger.KeyError, ErrRenderResponseWrap(err))
+		}
+		return
+	}
+	tenant := ""
+	matchers := api.Matchers{}
+	for _, matcher := range silence.Matchers {
+		if matcher.Name == s.service.serverConfig.Alerts.TenantLabel && !matcher.IsRegex && (matcher.IsEqual == nil || *matcher.IsEqual) {
+			tenant = matcher.Value
+		} else {
+			matchers = append(matchers, matcher)
+		}
+	}
+	if !slices.Contains(s.service.serverConfig.Alerts.Tenants, tenant) {
+		err := logger.Wrap(ErrInvalidSilence, ErrUnknownTenantWrap(errors.New(tenant)))
+		log.Warn("Unable to PostSilences", logger.KeyError, err)
+		if err = api.PostSilences400JSONResponse(err.Error()).VisitPostSilencesResponse(w); err != nil {
+			log.Error("Unable to render error response", logger.KeyError, ErrRenderResponseWrap(err))
+		}
+		return
+	}
+	silence.Matchers = matchers
+
+	silenceID, err := s.postSilence(r.Context(), tenant, silence)
+	if err != nil {
+		log.Error("Unable to PostSilences", logger.KeyError, err)
+		if err = api.PostSilences500JSONResponse(err.Error()).VisitPostSilencesResponse(w); err != nil {
+			log.Error("Unable to render error response", logger.KeyError, ErrRenderResponseWrap(err))
+		}
+		return
+	}
+
+	if err := (api.PostSilences200JSONResponse{SilenceID: &silenceID}).VisitPostSilencesResponse(w); err != nil {
+		log.Error("Unable to render response", logger.KeyError, ErrRenderResponseWrap(err))
+	}
+}
+
+// postSilence creates the silence in the tenant and returns the ID of the silence
+func (s *ApiServer) postSilence(ctx context.Context, tenant string, silence api.PostableSilence) (string, error) {
+	mimirResp, err := s.service.mimirClient.PostSilencesWithResponse(
+		ctx, silence, RequestHeaderSet(configs.HttpHeaderXscopeorgid, tenant),
+	)
+	if err != nil {
+		return "", ErrMimirResponseWrap(err)
+	}
+	if mimirResp.HTTPResponse.StatusCode != http.StatusOK || mimirResp.JSON200 == nil || mimirResp.JSON200.SilenceID == nil {
+		return "", ErrInvalidResponseStatusWrap(errors.New(mimirResp.HTTPResponse.Status))
+	}
+
+	return *mimirResp.JSON200.SilenceID, nil
+}
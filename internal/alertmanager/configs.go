@@ -0,0 +1,474 @@
+package alertmanager
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	html_tmpl "html/template"
+	"log/slog"
+	"maps"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"regexp"
+	"slices"
+	"strings"
+	text_tmpl "text/template"
+
+	yaml "github.com/goccy/go-yaml"
+	"github.com/pmezard/go-difflib/difflib"
+	am_config "github.com/prometheus/alertmanager/config"
+	"github.com/prometheus/alertmanager/template"
+
+	"github.com/pgillich/micro-server/pkg/logger"
+	mw_client "github.com/pgillich/micro-server/pkg/middleware/client"
+
+	"github.com/pgillich/mimir-multitenant_alertmanager/configs"
+	"github.com/pgillich/mimir-multitenant_alertmanager/internal/buildinfo"
+	api "github.com/pgillich/mimir-multitenant_alertmanager/pkg/api/alertmanager"
+	config_api "github.com/pgillich/mimir-multitenant_alertmanager/pkg/api/mimir_config"
+)
+
+const (
+	TargetServiceNameConfig = "mimir_alertmanager_config"
+
+	contentTypeYaml = "application/yaml"
+)
+
+var (
+	ErrUnknownTenant, ErrUnknownTenantWrap   = logger.WrapErr(errors.New("unknown tenant"))
+	ErrInvalidConfig                         = errors.New("invalid configuration")
+	ErrNoTenants                             = errors.New("no tenants selected")
+	ErrConfigPushDisabled                    = errors.New("config push is disabled")
+	ErrConfigBaseline, ErrConfigBaselineWrap = logger.WrapErr(errors.New("unable to load baseline configuration"))
+
+	templateRefRegexp = regexp.MustCompile(`{{-?\s*template\s+"([^"]+)"`)
+)
+
+// TenantConfigs reads, lints, diffs and pushes the Alertmanager configurations of the tenants in Mimir
+type TenantConfigs struct {
+	alertsConfig *configs.AlertsConfig
+	configUrl    string
+	client       *config_api.ClientWithResponses
+}
+
+func NewTenantConfigs(ctx context.Context, alertsConfig *configs.AlertsConfig, testConfig *configs.TestConfig, serviceName string,
+) (*TenantConfigs, error) {
+	_, log := logger.FromContext(ctx)
+	hostname, _ := os.Hostname() //nolint:errcheck // not important
+
+	tenantConfigs := &TenantConfigs{
+		alertsConfig: alertsConfig,
+		configUrl:    alertsConfig.ConfigUrl,
+	}
+	if tenantConfigs.configUrl == "" {
+		alertmanagerUrl, err := url.Parse(alertsConfig.AlertmanagerUrl)
+		if err != nil {
+			return nil, err
+		}
+		tenantConfigs.configUrl = (&url.URL{Scheme: alertmanagerUrl.Scheme, Host: alertmanagerUrl.Host, Path: "/"}).String()
+	}
+
+	httpClient := mw_client.NewHttpClient(hostname, serviceName, TargetServiceNameConfig,
+		buildinfo.BuildInfo, testConfig, log, slog.LevelInfo, slog.LevelInfo)
+	var err error
+	tenantConfigs.client, err = config_api.NewClientWithResponses(
+		tenantConfigs.configUrl,
+		config_api.WithHTTPClient(httpClient),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return tenantConfigs, nil
+}
+
+// ConfigUrl returns the base URL of the Mimir configuration API
+func (c *TenantConfigs) ConfigUrl() string {
+	return c.configUrl
+}
+
+// SelectTenants returns the configured tenants, if tenants is empty, else checks that all tenants are configured
+func (c *TenantConfigs) SelectTenants(tenants []string) ([]string, error) {
+	if len(tenants) == 0 {
+		return c.alertsConfig.Tenants, nil
+	}
+	for _, tenant := range tenants {
+		if !slices.Contains(c.alertsConfig.Tenants, tenant) {
+			return nil, ErrUnknownTenantWrap(errors.New(tenant))
+		}
+	}
+
+	return tenants, nil
+}
+
+// Get returns the Alertmanager configuration of the tenant, nil if the tenant has no configuration
+func (c *TenantConfigs) Get(ctx context.Context, tenant string) (*config_api.UserConfig, error) {
+	configResp, err := c.client.GetAlertmanagerConfigWithResponse(ctx, RequestHeaderSet(configs.HttpHeaderXscopeorgid, tenant))
+	if err != nil {
+		return nil, ErrMimirResponseWrap(err)
+	}
+	if configResp.StatusCode() == http.StatusNotFound {
+		return nil, nil //nolint:nilnil // not found is not an error
+	}
+	if configResp.StatusCode() != http.StatusOK || configResp.YAML200 == nil {
+		return nil, ErrInvalidResponseStatusWrap(errors.New(configResp.Status()))
+	}
+
+	return configResp.YAML200, nil
+}
+
+// Report gets, lints and diffs the Alertmanager configurations of the tenants.
+// The diff is skipped, if baseline is empty.
+func (c *TenantConfigs) Report(ctx context.Context, tenants []string, baseline string) ([]api.TenantConfig, error) {
+	report := make([]api.TenantConfig, 0, len(tenants))
+
+	for _, tenant := range tenants {
+		userConfig, err := c.Get(ctx, tenant)
+		if err != nil {
+			return nil, err
+		}
+		tenantConfig := api.TenantConfig{
+			Tenant:        tenant,
+			Found:         userConfig != nil,
+			TemplateFiles: []string{},
+			Findings:      api.ConfigFindings{},
+		}
+		if userConfig == nil {
+			report = append(report, tenantConfig)
+
+			continue
+		}
+		if userConfig.TemplateFiles != nil {
+			tenantConfig.TemplateFiles = slices.Sorted(maps.Keys(*userConfig.TemplateFiles))
+		}
+
+		amConfig, findings := LintConfig(userConfig)
+		tenantConfig.Findings = findings
+		if amConfig != nil {
+			normalized := amConfig.String()
+			tenantConfig.AlertmanagerConfig = &normalized
+			if baseline != "" {
+				diff, err := DiffConfig(baseline, normalized, tenant)
+				if err != nil {
+					return nil, err
+				}
+				if diff != "" {
+					tenantConfig.Diff = &diff
+				}
+			}
+		}
+		report = append(report, tenantConfig)
+	}
+
+	return report, nil
+}
+
+// Push validates the Alertmanager configuration and stores it to the tenants.
+// Nothing is pushed, if the push is disabled (alerts.configPush) or the configuration has error findings.
+func (c *TenantConfigs) Push(ctx context.Context, tenants []string, userConfig *config_api.UserConfig,
+) (api.TenantConfigPushResults, api.ConfigFindings, error) {
+	if !c.alertsConfig.ConfigPush {
+		return nil, nil, ErrConfigPushDisabled
+	}
+	if _, findings := LintConfig(userConfig); HasConfigError(findings) {
+		return nil, findings, ErrInvalidConfig
+	}
+	body, err := yaml.MarshalWithOptions(userConfig, yaml.UseLiteralStyleIfMultiline(true))
+	if err != nil {
+		return nil, nil, err
+	}
+
+	results := make(api.TenantConfigPushResults, 0, len(tenants))
+	for _, tenant := range tenants {
+		result := api.TenantConfigPushResult{Tenant: tenant}
+		configResp, err := c.client.SetAlertmanagerConfigWithBodyWithResponse(ctx, contentTypeYaml, bytes.NewReader(body),
+			RequestHeaderSet(configs.HttpHeaderXscopeorgid, tenant),
+		)
+		switch {
+		case err != nil:
+			errStr := ErrMimirResponseWrap(err).Error()
+			result.Error = &errStr
+		case configResp.StatusCode() != http.StatusCreated && configResp.StatusCode() != http.StatusOK:
+			errStr := ErrInvalidResponseStatusWrap(fmt.Errorf("%s %s", configResp.Status(), strings.TrimSpace(string(configResp.Body)))).Error()
+			result.Error = &errStr
+		default:
+			result.Pushed = true
+		}
+		results = append(results, result)
+	}
+
+	return results, nil, nil
+}
+
+// LoadConfigBaseline loads the central Alertmanager configuration file and returns its normalized form.
+// The relative template paths are kept (not resolved to the file directory), like in the tenant configurations.
+func LoadConfigBaseline(path string) (string, error) {
+	if path == "" {
+		return "", nil
+	}
+	content, err := os.ReadFile(path) //nolint:gosec // configured path
+	if err != nil {
+		return "", ErrConfigBaselineWrap(err)
+	}
+	amConfig, err := am_config.Load(string(content))
+	if err != nil {
+		return "", ErrConfigBaselineWrap(err)
+	}
+
+	return amConfig.String(), nil
+}
+
+// LintConfig validates the Alertmanager configuration like Mimir does and returns the findings.
+// The parsed configuration is returned, if it's loadable.
+func LintConfig(userConfig *config_api.UserConfig) (*am_config.Config, api.ConfigFindings) {
+	findings := api.ConfigFindings{}
+	templateFiles := map[string]string{}
+	if userConfig.TemplateFiles != nil {
+		templateFiles = *userConfig.TemplateFiles
+	}
+
+	amConfig, err := am_config.Load(userConfig.AlertmanagerConfig)
+	if err != nil {
+		findings = append(findings, api.ConfigFinding{Severity: api.ConfigFindingError, Message: err.Error()})
+	} else {
+		findings = append(findings, lintReceivers(amConfig)...)
+		findings = append(findings, lintTemplatePatterns(amConfig.Templates, templateFiles)...)
+	}
+	findings = append(findings, lintTemplates(userConfig.AlertmanagerConfig, templateFiles)...)
+
+	return amConfig, findings
+}
+
+// HasConfigError checks if there is an error in the findings
+func HasConfigError(findings api.ConfigFindings) bool {
+	return slices.ContainsFunc(findings, func(finding api.ConfigFinding) bool {
+		return finding.Severity == api.ConfigFindingError
+	})
+}
+
+// DiffConfig returns the unified diff of the normalized configurations
+func DiffConfig(baseline string, config string, tenant string) (string, error) {
+	return difflib.GetUnifiedDiffString(difflib.UnifiedDiff{
+		A:        difflib.SplitLines(baseline),
+		B:        difflib.SplitLines(config),
+		FromFile: "baseline",
+		ToFile:   tenant,
+		Context:  3,
+	})
+}
+
+// lintReceivers reports the receivers, which are not used by any route.
+// The undefined receivers are reported by am_config.Load.
+func lintReceivers(amConfig *am_config.Config) api.ConfigFindings {
+	findings := api.ConfigFindings{}
+	used := map[string]bool{}
+	var walk func(route *am_config.Route)
+	walk = func(route *am_config.Route) {
+		used[route.Receiver] = true
+		for _, child := range route.Routes {
+			walk(child)
+		}
+	}
+	walk(amConfig.Route)
+
+	for _, receiver := range amConfig.Receivers {
+		if !used[receiver.Name] {
+			findings = append(findings, api.ConfigFinding{
+				Severity: api.ConfigFindingWarning,
+				Message:  fmt.Sprintf("receiver %q is not used by any route", receiver.Name),
+			})
+		}
+	}
+
+	return findings
+}
+
+// lintTemplatePatterns reports the template patterns without template file and the template files without pattern.
+// Mimir stores the template files by base name, so the patterns are matched by base name, too.
+func lintTemplatePatterns(patterns []string, templateFiles map[string]string) api.ConfigFindings {
+	findings := api.ConfigFindings{}
+	referenced := map[string]bool{}
+
+	for _, pattern := range patterns {
+		matched := false
+		for name := range templateFiles {
+			if ok, err := filepath.Match(filepath.Base(pattern), name); err == nil && ok {
+				matched = true
+				referenced[name] = true
+			}
+		}
+		if !matched {
+			findings = append(findings, api.ConfigFinding{
+				Severity: api.ConfigFindingError,
+				Message:  fmt.Sprintf("template pattern %q matches no template file", pattern),
+			})
+		}
+	}
+	for _, name := range slices.Sorted(maps.Keys(templateFiles)) {
+		if !referenced[name] {
+			findings = append(findings, api.ConfigFinding{
+				Severity: api.ConfigFindingWarning,
+				Message:  fmt.Sprintf("template file %q is not referenced by templates", name),
+			})
+		}
+	}
+
+	return findings
+}
+
+// lintTemplates parses the template files and reports the undefined templates,
+// which are referenced by the configuration or by the template files
+func lintTemplates(amConfig string, templateFiles map[string]string) api.ConfigFindings {
+	findings := api.ConfigFindings{}
+	var textTemplate *text_tmpl.Template
+	tmpl, err := template.FromGlobs(nil, registerMimirFuncs, func(text *text_tmpl.Template, _ *html_tmpl.Template) {
+		textTemplate = text
+	})
+	if err != nil {
+		return append(findings, api.ConfigFinding{Severity: api.ConfigFindingError, Message: err.Error()})
+	}
+
+	names := slices.Sorted(maps.Keys(templateFiles))
+	for _, name := range names {
+		if err := tmpl.Parse(strings.NewReader(templateFiles[name])); err != nil {
+			findings = append(findings, api.ConfigFinding{
+				Severity: api.ConfigFindingError,
+				Message:  fmt.Sprintf("template file %q: %s", name, err),
+			})
+		}
+	}
+
+	sources := append([]string{amConfig}, names...)
+	reported := map[string]bool{}
+	for s, source := range sources {
+		where := "configuration"
+		content := source
+		if s > 0 {
+			where = fmt.Sprintf("template file %q", source)
+			content = templateFiles[source]
+		}
+		for _, ref := range templateRefRegexp.FindAllStringSubmatch(content, -1) {
+			if reported[ref[1]] || textTemplate.Lookup(ref[1]) != nil {
+				continue
+			}
+			reported[ref[1]] = true
+			findings = append(findings, api.ConfigFinding{
+				Severity: api.ConfigFindingError,
+				Message:  fmt.Sprintf("undefined template %q referenced by %s", ref[1], where),
+			})
+		}
+	}
+
+	return findings
+}
+
+// registerMimirFuncs registers the template functions, which are added by Mimir.
+// The functions are only parsed, so the implementations are dummy.
+func registerMimirFuncs(text *text_tmpl.Template, html *html_tmpl.Template) {
+	funcs := map[string]any{
+		"tenantID":              func() string { return "" },
+		"grafanaExploreURL":     func(string, string, string, string, string) (string, error) { return "", nil },
+		"queryFromGeneratorURL": func(string) (string, error) { return "", nil },
+	}
+	text.Funcs(funcs)
+	html.Funcs(funcs)
+}
+
+func (s *ApiServer) GetTenantConfigs(w http.ResponseWriter, r *http.Request, params api.GetTenantConfigsParams) {
+	_, log := logger.FromContext(r.Context(), "configUrl", s.service.tenantConfigs.ConfigUrl())
+	var selected []string
+	if params.Tenant != nil {
+		selected = *params.Tenant
+	}
+	tenants, err := s.service.tenantConfigs.SelectTenants(selected)
+	if err != nil {
+		log.Warn("Unable to GetTenantConfigs", logger.KeyError, err)
+		if err = api.GetTenantConfigs400JSONResponse(err.Error()).VisitGetTenantConfigsResponse(w); err != nil {
+			log.Error("Unable to render error response", logger.KeyError, ErrRenderResponseWrap(err))
+		}
+		return
+	}
+
+	report, err := s.service.tenantConfigs.Report(r.Context(), tenants, s.service.configBaseline)
+	if err != nil {
+		log.Error("Unable to GetTenantConfigs", logger.KeyError, err)
+		if err = api.GetTenantConfigs500JSONResponse(err.Error()).VisitGetTenantConfigsResponse(w); err != nil {
+			log.Error("Unable to render error response", logger.KeyError, ErrRenderResponseWrap(err))
+		}
+		return
+	}
+
+	if err := api.GetTenantConfigs200JSONResponse(report).VisitGetTenantConfigsResponse(w); err != nil {
+		log.Error("Unable to render response", logger.KeyError, ErrRenderResponseWrap(err))
+	}
+}
+
+func (s *ApiServer) PostTenantConfigs(w http.ResponseWriter, r *http.Request) {
+	_, log := logger.FromContext(r.Context(), "configUrl", s.service.tenantConfigs.ConfigUrl())
+	if !s.service.serverConfig.Alerts.ConfigPush {
+		if err := api.PostTenantConfigs403JSONResponse(ErrConfigPushDisabled.Error()).VisitPostTenantConfigsResponse(w); err != nil {
+			log.Error("Unable to render error response", logger.KeyError, ErrRenderResponseWrap(err))
+		}
+		return
+	}
+
+	var body api.PostableTenantConfig
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		log.Warn("Unable to PostTenantConfigs", logger.KeyError, err)
+		if err = api.PostTenantConfigs400JSONResponse(configErrorFindings(err)).VisitPostTenantConfigsResponse(w); err != nil {
+			log.Error("Unable to render error response", logger.KeyError, ErrRenderResponseWrap(err))
+		}
+		return
+	}
+	tenants, err := s.service.tenantConfigs.SelectTenants(body.Tenants)
+	if err == nil && len(body.Tenants) == 0 {
+		err = ErrNoTenants
+	}
+	if err != nil {
+		log.Warn("Unable to PostTenantConfigs", logger.KeyError, err)
+		if err = api.PostTenantConfigs400JSONResponse(configErrorFindings(err)).VisitPostTenantConfigsResponse(w); err != nil {
+			log.Error("Unable to render error response", logger.KeyError, ErrRenderResponseWrap(err))
+		}
+		return
+	}
+
+	userConfig := &config_api.UserConfig{AlertmanagerConfig: body.AlertmanagerConfig}
+	if body.TemplateFiles != nil {
+		templateFiles := map[string]string(*body.TemplateFiles)
+		userConfig.TemplateFiles = &templateFiles
+	}
+	results, findings, err := s.service.tenantConfigs.Push(r.Context(), tenants, userConfig)
+	switch {
+	case errors.Is(err, ErrInvalidConfig):
+		log.Warn("Unable to PostTenantConfigs", logger.KeyError, err)
+		if err = api.PostTenantConfigs400JSONResponse(findings).VisitPostTenantConfigsResponse(w); err != nil {
+			log.Error("Unable to render error response", logger.KeyError, ErrRenderResponseWrap(err))
+		}
+		return
+	case err != nil:
+		log.Error("Unable to PostTenantConfigs", logger.KeyError, err)
+		if err = api.PostTenantConfigs500JSONResponse(err.Error()).VisitPostTenantConfigsResponse(w); err != nil {
+			log.Error("Unable to render error response", logger.KeyError, ErrRenderResponseWrap(err))
+		}
+		return
+	}
+	for _, result := range results {
+		if result.Error != nil {
+			log.Error("Unable to push config", "tenant", result.Tenant, logger.KeyError, *result.Error)
+		} else {
+			log.Info("Config pushed", "tenant", result.Tenant)
+		}
+	}
+
+	if err := api.PostTenantConfigs200JSONResponse(results).VisitPostTenantConfigsResponse(w); err != nil {
+		log.Error("Unable to render response", logger.KeyError, ErrRenderResponseWrap(err))
+	}
+}
+
+func configErrorFindings(err error) api.ConfigFindings {
+	return api.ConfigFindings{{Severity: api.ConfigFindingError, Message: err.Error()}}
+}
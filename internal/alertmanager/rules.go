@@ -0,0 +1,156 @@
+package alertmanager
+
+import (
+	"errors"
+	"log/slog"
+	"maps"
+	"net/http"
+
+	"github.com/pgillich/micro-server/pkg/logger"
+
+	"github.com/pgillich/mimir-multitenant_alertmanager/configs"
+	api "github.com/pgillich/mimir-multitenant_alertmanager/pkg/api/alertmanager"
+	ruler_api "github.com/pgillich/mimir-multitenant_alertmanager/pkg/api/ruler"
+)
+
+const (
+	AnnotationRuleGroup  = "rule_group"
+	AnnotationRuleExpr   = "rule_expr"
+	AnnotationRuleHealth = "rule_health"
+
+	LabelAlertname = "alertname"
+)
+
+var (
+	ErrRulerResponse, ErrRulerResponseWrap = logger.WrapErr(errors.New("ruler response"))
+)
+
+func (s *ApiServer) GetRules(w http.ResponseWriter, r *http.Request, params api.GetRulesParams) {
+	_, log := logger.FromContext(r.Context(), "rulerUrl", s.service.rulerUrl)
+	ruleGroups := []api.TenantRuleGroup{}
+	rulerParams := &ruler_api.GetRulesParams{}
+	if params.Type != nil {
+		ruleType := ruler_api.GetRulesParamsType(*params.Type)
+		rulerParams.Type = &ruleType
+	}
+	joinAlerts := params.Alerts != nil && *params.Alerts
+
+	for _, tenant := range s.service.serverConfig.Alerts.Tenants {
+		rulerResp, err := s.service.rulerClient.GetRulesWithResponse(
+			r.Context(), rulerParams, RequestHeaderSet(configs.HttpHeaderXscopeorgid, tenant),
+		)
+		if err != nil {
+			err = ErrRulerResponseWrap(err)
+			log.Error("Unable to GetRules", logger.KeyError, err)
+			if err = api.GetRules500JSONResponse(err.Error()).VisitGetRulesResponse(w); err != nil {
+				log.Error("Unable to render error response", logger.KeyError, ErrRenderResponseWrap(err))
+			}
+			return
+		}
+		if rulerResp.HTTPResponse.StatusCode != http.StatusOK || rulerResp.JSON200 == nil || rulerResp.JSON200.Data == nil {
+			err = ErrInvalidResponseStatusWrap(errors.New(rulerResp.HTTPResponse.Status))
+			log.Error("Unable to GetRules", logger.KeyError, err)
+			if err = api.GetRules500JSONResponse(err.Error()).VisitGetRulesResponse(w); err != nil {
+				log.Error("Unable to render error response", logger.KeyError, ErrRenderResponseWrap(err))
+			}
+			return
+		}
+
+		alerts := api.GettableAlerts{}
+		if joinAlerts {
+			if alerts, err = s.getUpstreamAlerts(r.Context(), tenant, &api.GetAlertsParams{}); err != nil {
+				log.Error("Unable to GetRules", logger.KeyError, err)
+				if err = api.GetRules500JSONResponse(err.Error()).VisitGetRulesResponse(w); err != nil {
+					log.Error("Unable to render error response", logger.KeyError, ErrRenderResponseWrap(err))
+				}
+				return
+			}
+			for a := range alerts {
+				s.tenantAlert(log, tenant, &alerts[a])
+			}
+		}
+
+		for _, group := range rulerResp.JSON200.Data.Groups {
+			ruleGroups = append(ruleGroups, s.tenantRuleGroup(log, tenant, group, alerts, joinAlerts))
+		}
+	}
+
+	if err := api.GetRules200JSONResponse(ruleGroups).VisitGetRulesResponse(w); err != nil {
+		log.Error("Unable to render response", logger.KeyError, ErrRenderResponseWrap(err))
+	}
+}
+
+// tenantRuleGroup converts the rule group of a tenant to the multi-tenant view and joins the active alerts
+func (s *ApiServer) tenantRuleGroup(log *slog.Logger, tenant string, group ruler_api.RuleGroup,
+	alerts api.GettableAlerts, joinAlerts bool,
+) api.TenantRuleGroup {
+	tenantGroup := api.TenantRuleGroup{
+		Tenant:   tenant,
+		Name:     group.Name,
+		File:     group.File,
+		Interval: group.Interval,
+		Rules:    make([]api.TenantRule, 0, len(group.Rules)),
+	}
+
+	for _, rule := range group.Rules {
+		tenantRule := api.TenantRule{
+			Name:      rule.Name,
+			Query:     rule.Query,
+			Type:      rule.Type,
+			Health:    rule.Health,
+			LastError: rule.LastError,
+			State:     rule.State,
+			Labels:    api.LabelSet{},
+		}
+		if rule.Labels != nil {
+			maps.Copy(tenantRule.Labels, *rule.Labels)
+		}
+		tenantRule.Labels[s.service.serverConfig.Alerts.TenantLabel] = tenant
+		SetTenantMeta(log, s.service.serverConfig.Alerts, tenant, tenantRule.Labels, api.LabelSet{})
+		if rule.Annotations != nil {
+			annotations := api.LabelSet(*rule.Annotations)
+			tenantRule.Annotations = &annotations
+		}
+		if joinAlerts && rule.Type == "alerting" {
+			ruleAlerts := joinRuleAlerts(group.Name, tenantRule, alerts)
+			log.Debug("Rule alerts joined", "tenant", tenant, "group", group.Name, "rule", rule.Name, "alerts", len(ruleAlerts))
+			tenantRule.Alerts = &ruleAlerts
+		}
+		tenantGroup.Rules = append(tenantGroup.Rules, tenantRule)
+	}
+
+	return tenantGroup
+}
+
+// joinRuleAlerts selects the alerts of the rule by alertname and rule labels.
+// The rule group, expression and evaluation health are added to the annotations of the selected alerts.
+func joinRuleAlerts(groupName string, rule api.TenantRule, alerts api.GettableAlerts) api.GettableAlerts {
+	ruleAlerts := api.GettableAlerts{}
+
+	for _, alert := range alerts {
+		if alert.Labels[LabelAlertname] != rule.Name {
+			continue
+		}
+		matched := true
+		for name, value := range rule.Labels {
+			if alert.Labels[name] != value {
+				matched = false
+				break
+			}
+		}
+		if !matched {
+			continue
+		}
+
+		alert.Annotations = maps.Clone(alert.Annotations)
+		if alert.Annotations == nil {
+			alert.Annotations = api.LabelSet{}
+		}
+		alert.Annotations[AnnotationRuleGroup] = groupName
+		alert.Annotations[AnnotationRuleExpr] = rule.Query
+		alert.Annotations[AnnotationRuleHealth] = rule.Health
+		ruleAlerts = append(ruleAlerts, alert)
+	}
+
+	return ruleAlerts
+}
@@ -4,8 +4,10 @@ import (
 	"context"
 	"errors"
 	"log/slog"
+	"net/url"
 	"os"
 	"path"
+	"time"
 
 	"github.com/go-chi/chi/v5"
 	"go.opentelemetry.io/otel/trace"
@@ -19,10 +21,12 @@ import (
 	"github.com/pgillich/mimir-multitenant_alertmanager/configs"
 	"github.com/pgillich/mimir-multitenant_alertmanager/internal/buildinfo"
 	api "github.com/pgillich/mimir-multitenant_alertmanager/pkg/api/alertmanager"
+	ruler_api "github.com/pgillich/mimir-multitenant_alertmanager/pkg/api/ruler"
 )
 
 const (
-	TargetServiceName = "mimir_alertmanager"
+	TargetServiceName      = "mimir_alertmanager"
+	TargetServiceNameRuler = "mimir_ruler"
 )
 
 var (
@@ -34,6 +38,13 @@ type HttpService struct {
 	testConfig   *configs.TestConfig
 	apiServer    *ApiServer
 	mimirClient  *api.ClientWithResponses
+	rulerUrl     string
+	rulerClient  *ruler_api.ClientWithResponses
+
+	tenantConfigs  *TenantConfigs
+	configBaseline string
+	acks           *AckStore
+	shutdown       chan struct{}
 }
 
 func newHttpService() model.HttpServicer {
@@ -53,6 +64,7 @@ func (s *HttpService) Prepare(ctx context.Context, serverConfig srv_configs.Serv
 ) error {
 	_, log := logger.FromContext(ctx)
 	hostname, _ := os.Hostname() //nolint:errcheck // not important
+	s.shutdown = make(chan struct{})
 
 	var is bool
 	s.serverConfig, is = serverConfig.(*configs.ServerConfig)
@@ -64,6 +76,9 @@ func (s *HttpService) Prepare(ctx context.Context, serverConfig srv_configs.Serv
 	if !is {
 		return srv_configs.ErrFatalServerConfig
 	}
+	if err := append(s.serverConfig.Validate(), s.serverConfig.Alerts.Validate()...).Err(); err != nil {
+		return logger.Wrap(ErrUnableToPrepareService, err)
+	}
 	httpClient := mw_client.NewHttpClient(hostname, configs.ServiceNameAlertmanager, TargetServiceName,
 		buildinfo.BuildInfo, s.testConfig, log, slog.LevelInfo, slog.LevelInfo)
 
@@ -76,6 +91,39 @@ func (s *HttpService) Prepare(ctx context.Context, serverConfig srv_configs.Serv
 		return logger.Wrap(ErrUnableToPrepareService, err)
 	}
 
+	s.rulerUrl = s.serverConfig.Alerts.RulerUrl
+	if s.rulerUrl == "" {
+		alertmanagerUrl, err := url.Parse(s.serverConfig.Alerts.AlertmanagerUrl)
+		if err != nil {
+			return logger.Wrap(ErrUnableToPrepareService, err)
+		}
+		s.rulerUrl = (&url.URL{Scheme: alertmanagerUrl.Scheme, Host: alertmanagerUrl.Host, Path: configs.DefaultRulerPath}).String()
+	}
+	rulerHttpClient := mw_client.NewHttpClient(hostname, configs.ServiceNameAlertmanager, TargetServiceNameRuler,
+		buildinfo.BuildInfo, s.testConfig, log, slog.LevelInfo, slog.LevelInfo)
+	s.rulerClient, err = ruler_api.NewClientWithResponses(
+		s.rulerUrl,
+		ruler_api.WithHTTPClient(rulerHttpClient),
+	)
+	if err != nil {
+		return logger.Wrap(ErrUnableToPrepareService, err)
+	}
+
+	s.tenantConfigs, err = NewTenantConfigs(ctx, s.serverConfig.Alerts, s.testConfig, configs.ServiceNameAlertmanager)
+	if err != nil {
+		return logger.Wrap(ErrUnableToPrepareService, err)
+	}
+	s.configBaseline, err = LoadConfigBaseline(s.serverConfig.Alerts.ConfigBaseline)
+	if err != nil {
+		return logger.Wrap(ErrUnableToPrepareService, err)
+	}
+
+	s.acks, err = NewAckStore(s.serverConfig.Alerts.AckPath, time.Now)
+	if err != nil {
+		return logger.Wrap(ErrUnableToPrepareService, err)
+	}
+	s.runAckResolver(ctx, s.shutdown)
+
 	api.HandlerWithOptions(s.apiServer, api.ChiServerOptions{
 		BaseURL:    path.Join("/", configs.ServiceNameAlertmanager, "/api/v2"),
 		BaseRouter: httpRouter,
@@ -89,5 +137,6 @@ func (s *HttpService) Start(ctx context.Context) error {
 }
 
 func (s *HttpService) Stop(ctx context.Context) error {
+	close(s.shutdown)
 	return nil
 }
@@ -0,0 +1,67 @@
+package cmd
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"io"
+	"os"
+
+	yaml "github.com/goccy/go-yaml"
+
+	mw_client_model "github.com/pgillich/micro-server/pkg/middleware/client/model"
+
+	"github.com/pgillich/mimir-multitenant_alertmanager/configs"
+	api "github.com/pgillich/mimir-multitenant_alertmanager/pkg/api/notifyer"
+)
+
+var ErrNoCapturedAlerts = errors.New("no captured alerts")
+
+// loadAlertsFile reads alerts from a JSON file (GetAlerts response body) or from a capture file of GetAlerts requests.
+// The alerts of the capture documents are merged. If a captured request has X-Scope-Orgid header (upstream Mimir response),
+// the tenant label is set on its alerts, like the multitenant-alertmanager service does.
+func loadAlertsFile(path string, tenantLabel string) (api.GettableAlerts, error) {
+	content, err := os.ReadFile(path) //nolint:gosec // file given by the user
+	if err != nil {
+		return nil, err
+	}
+	alerts := api.GettableAlerts{}
+	if trimmed := bytes.TrimSpace(content); len(trimmed) > 0 && trimmed[0] == '[' {
+		err = json.Unmarshal(content, &alerts)
+
+		return alerts, err
+	}
+
+	decoder := yaml.NewDecoder(bytes.NewReader(content))
+	found := false
+	for {
+		capItem := &mw_client_model.CaptureItem{}
+		if err := decoder.Decode(capItem); errors.Is(err, io.EOF) {
+			break
+		} else if err != nil {
+			return nil, err
+		}
+		if capItem.Response.Body == nil || capItem.Response.StatusCode != 200 {
+			continue
+		}
+		capAlerts := api.GettableAlerts{}
+		if err := json.Unmarshal([]byte(*capItem.Response.Body), &capAlerts); err != nil {
+			return nil, err
+		}
+		if tenant := capItem.Request.Header.Get(configs.HttpHeaderXscopeorgid); tenant != "" {
+			for a := range capAlerts {
+				if capAlerts[a].Labels == nil {
+					capAlerts[a].Labels = api.LabelSet{}
+				}
+				capAlerts[a].Labels[tenantLabel] = tenant
+			}
+		}
+		alerts = append(alerts, capAlerts...)
+		found = true
+	}
+	if !found {
+		return nil, ErrNoCapturedAlerts
+	}
+
+	return alerts, nil
+}
@@ -0,0 +1,65 @@
+package cmd
+
+import (
+	"fmt"
+	"slices"
+
+	"github.com/spf13/cobra"
+
+	"github.com/pgillich/mimir-multitenant_alertmanager/configs"
+	notifyer "github.com/pgillich/mimir-multitenant_alertmanager/internal/notifyer"
+)
+
+const CommandNameCheckConfig = "check-config"
+
+var checkConfigCmd = &cobra.Command{ //nolint:gochecknoglobals // cobra
+	Use:   CommandNameCheckConfig,
+	Short: "Validate the config file",
+	Long: `Validates the config file the same way as the services do at startup.
+The URLs, tenants, routes, receivers, email configs and templates are checked.
+The notifyer config is checked, if it's set or the notifyer service is selected (--service).
+The errors are printed with the YAML path of the invalid value and the command fails.`,
+	Args: cobra.NoArgs,
+	RunE: runCheckConfig,
+}
+
+func init() {
+	rootCmd.AddCommand(checkConfigCmd)
+	checkConfigCmd.Flags().StringSlice("service", nil,
+		"Services to check the config for (default: "+configs.ServiceNameAlertmanager+" and the configured ones)")
+}
+
+func runCheckConfig(cmd *cobra.Command, args []string) error {
+	serverConfig, _, err := readConfig(cmd)
+	if err != nil {
+		return err
+	}
+	services, err := cmd.Flags().GetStringSlice("service")
+	if err != nil {
+		return err
+	}
+	if len(services) == 0 {
+		services = []string{configs.ServiceNameAlertmanager}
+		if serverConfig.Notifyer != nil {
+			services = append(services, configs.ServiceNameNotifyer)
+		}
+	}
+
+	configErrors := serverConfig.Validate()
+	if slices.Contains(services, configs.ServiceNameAlertmanager) {
+		configErrors = append(configErrors, serverConfig.Alerts.Validate()...)
+	}
+	if slices.Contains(services, configs.ServiceNameNotifyer) {
+		configErrors = append(configErrors, notifyer.ValidateConfig(serverConfig.Alerts, serverConfig.Notifyer)...)
+	}
+	if len(configErrors) > 0 {
+		if err := printYaml(cmd, configErrors); err != nil {
+			return err
+		}
+
+		return configErrors.Err()
+	}
+	_, err = fmt.Fprintln(cmd.OutOrStdout(), "Config is valid")
+
+	return err
+}
@@ -0,0 +1,119 @@
+package cmd
+
+import (
+	"context"
+	"log/slog"
+	"os"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	srv_cmd "github.com/pgillich/micro-server/pkg/cmd"
+	srv_configs "github.com/pgillich/micro-server/pkg/configs"
+	"github.com/pgillich/micro-server/pkg/logger"
+	"github.com/pgillich/micro-server/pkg/model"
+
+	"github.com/pgillich/mimir-multitenant_alertmanager/configs"
+	"github.com/pgillich/mimir-multitenant_alertmanager/internal/buildinfo"
+)
+
+const defaultConfigName = ".server_runner"
+
+// rootCmd holds the tool subcommands. The services command is served by micro-server.
+var rootCmd = &cobra.Command{ //nolint:gochecknoglobals // cobra
+	Use:   buildinfo.BuildInfo.AppName(),
+	Short: "Multi-tenant Alertmanager for Mimir",
+}
+
+func init() {
+	rootCmd.PersistentFlags().String("config", "", "config file (default is $HOME/"+defaultConfigName+".yaml)")
+}
+
+// Execute runs the tool subcommands of this repo or the commands of micro-server (services)
+func Execute(ctx context.Context, args []string, buildInfo model.BuildInfo, serverConfig srv_configs.ServerConfiger,
+	testConfig srv_configs.TestConfiger,
+) {
+	subCmd, subArgs, err := rootCmd.Find(args)
+	if err != nil || subCmd == rootCmd {
+		srv_cmd.Execute(ctx, args, buildInfo, serverConfig, testConfig)
+
+		return
+	}
+
+	ctx = context.WithValue(ctx, model.CtxKeyCmd, strings.Join(append([]string{rootCmd.Use}, args...), " "))
+	ctx = context.WithValue(ctx, model.CtxKeyBuildInfo, buildInfo)
+	ctx = context.WithValue(ctx, model.CtxKeyServerConfig, serverConfig)
+	ctx = context.WithValue(ctx, model.CtxKeyTestConfig, testConfig)
+	subCmd.SetContext(ctx)
+	if err := runCommand(subCmd, subArgs); err != nil {
+		logger.GetLogger(rootCmd.Use, slog.LevelDebug).Error("EXECUTE_FAILED", logger.KeyError, err, "args", args)
+		os.Exit(1)
+	}
+}
+
+// runCommand runs the command without cobra.Command.Execute,
+// because the global cobra initializer of micro-server would read its own config file
+func runCommand(cmd *cobra.Command, args []string) error {
+	cmd.InitDefaultHelpFlag()
+	if err := cmd.ParseFlags(args); err != nil {
+		return err
+	}
+	if help, err := cmd.Flags().GetBool("help"); err == nil && help {
+		return cmd.Help()
+	}
+	if err := cmd.ValidateArgs(cmd.Flags().Args()); err != nil {
+		return err
+	}
+
+	return cmd.RunE(cmd, cmd.Flags().Args())
+}
+
+// loadConfig reads the config file the same way as the services command does and validates the alerts config
+func loadConfig(cmd *cobra.Command) (*configs.ServerConfig, *configs.TestConfig, error) {
+	serverConfig, testConfig, err := readConfig(cmd)
+	if err != nil {
+		return nil, nil, err
+	}
+	if err := serverConfig.Alerts.Validate().Err(); err != nil {
+		return nil, nil, err
+	}
+
+	return serverConfig, testConfig, nil
+}
+
+// readConfig reads the config file the same way as the services command does, without validation
+func readConfig(cmd *cobra.Command) (*configs.ServerConfig, *configs.TestConfig, error) {
+	serverConfig, is := cmd.Context().Value(model.CtxKeyServerConfig).(*configs.ServerConfig)
+	if !is {
+		return nil, nil, srv_configs.ErrFatalServerConfig
+	}
+	testConfig, is := cmd.Context().Value(model.CtxKeyTestConfig).(*configs.TestConfig)
+	if !is {
+		return nil, nil, srv_configs.ErrFatalServerConfig
+	}
+
+	cmdViper := viper.New()
+	cfgFile, err := cmd.Flags().GetString("config")
+	if err != nil {
+		return nil, nil, err
+	}
+	if cfgFile != "" {
+		cmdViper.SetConfigFile(cfgFile)
+	} else {
+		home, err := os.UserHomeDir()
+		if err != nil {
+			return nil, nil, err
+		}
+		cmdViper.AddConfigPath(home)
+		cmdViper.SetConfigType("yaml")
+		cmdViper.SetConfigName(defaultConfigName)
+	}
+	if err := cmdViper.ReadInConfig(); err != nil {
+		return nil, nil, err
+	}
+	if err := cmdViper.Unmarshal(serverConfig); err != nil {
+		return nil, nil, err
+	}
+	return serverConfig, testConfig, nil
+}
@@ -0,0 +1,210 @@
+package cmd
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/pgillich/mimir-multitenant_alertmanager/configs"
+	notifyer "github.com/pgillich/mimir-multitenant_alertmanager/internal/notifyer"
+	api "github.com/pgillich/mimir-multitenant_alertmanager/pkg/api/notifyer"
+)
+
+const (
+	CommandNameRoutes     = "routes"
+	CommandNameRoutesTest = "test"
+
+	outputText = "text"
+	outputYaml = "yaml"
+)
+
+var (
+	ErrInvalidLabel  = errors.New("invalid label, name=value expected")
+	ErrInvalidOutput = errors.New("invalid output format")
+	ErrNoLabels      = errors.New("either labels or --fingerprint must be given")
+	ErrRoutesTest    = errors.New("unexpected receivers")
+)
+
+var routesCmd = &cobra.Command{ //nolint:gochecknoglobals // cobra
+	Use:   CommandNameRoutes,
+	Short: "Show the notification routing tree",
+	Long: `Shows the routing tree of the notifyer (notifyer.route).
+The text output draws the tree with the matchers, receivers, group_by and timing settings of the routes.`,
+	Args: cobra.NoArgs,
+	RunE: runRoutes,
+}
+
+var routesTestCmd = &cobra.Command{ //nolint:gochecknoglobals // cobra
+	Use:   CommandNameRoutesTest + " [name=value...]",
+	Short: "Test where an alert would be routed",
+	Long: `Tests the label set against the routing tree of the notifyer, without sending a notification.
+The labels are given as arguments, or the labels of an aggregated alert are used (--fingerprint).
+The alert is looked for in the alerts file (--alerts) or in the live alerts.
+The matched route path, receiver, group key and timing settings are printed.
+With --expect, the command fails, if the matched receivers differ.`,
+	RunE: runRoutesTest,
+}
+
+func init() {
+	rootCmd.AddCommand(routesCmd)
+	routesCmd.Flags().StringP("output", "o", outputText, "Output format (text, yaml)")
+	routesCmd.AddCommand(routesTestCmd)
+	routesTestCmd.Flags().String("fingerprint", "", "Fingerprint of an aggregated alert")
+	routesTestCmd.Flags().String("alerts", "", "Alerts JSON or capture file (default: live alerts)")
+	routesTestCmd.Flags().StringSlice("expect", nil, "Expected receivers")
+}
+
+func runRoutes(cmd *cobra.Command, args []string) error {
+	serverConfig, _, err := loadConfig(cmd)
+	if err != nil {
+		return err
+	}
+	if serverConfig.Notifyer == nil {
+		return ErrMissingNotifyerConfig
+	}
+	output, err := cmd.Flags().GetString("output")
+	if err != nil {
+		return err
+	}
+	notifyerConfig, err := notifyer.WithTenantConfigs(serverConfig.Alerts, serverConfig.Notifyer)
+	if err != nil {
+		return err
+	}
+	tree, err := notifyer.NewRouteTree(notifyerConfig.Route)
+	if err != nil {
+		return err
+	}
+	root := notifyer.RouteTreeNode(tree)
+
+	switch output {
+	case outputYaml:
+		return printYaml(cmd, root)
+	case outputText:
+		return printRouteTree(cmd.OutOrStdout(), root, "", "")
+	default:
+		return fmt.Errorf("%w: %s", ErrInvalidOutput, output)
+	}
+}
+
+// printRouteTree draws the routing tree, like amtool does
+func printRouteTree(out io.Writer, node api.RouteNode, prefix string, childPrefix string) error {
+	matchers := "{" + strings.Join(node.Matchers, ", ") + "}"
+	settings := []string{"receiver: " + node.Receiver}
+	if node.GroupByAll {
+		settings = append(settings, "group_by: [...]")
+	} else if len(node.GroupBy) > 0 {
+		settings = append(settings, "group_by: ["+strings.Join(node.GroupBy, ", ")+"]")
+	}
+	settings = append(settings, "wait: "+node.GroupWait, "interval: "+node.GroupInterval, "repeat: "+node.RepeatInterval)
+	if node.Continue {
+		settings = append(settings, "continue")
+	}
+	if node.MuteTimeIntervals != nil {
+		settings = append(settings, "mute: ["+strings.Join(*node.MuteTimeIntervals, ", ")+"]")
+	}
+	if node.ActiveTimeIntervals != nil {
+		settings = append(settings, "active: ["+strings.Join(*node.ActiveTimeIntervals, ", ")+"]")
+	}
+	if _, err := fmt.Fprintf(out, "%s%s  %s\n", prefix, matchers, strings.Join(settings, "  ")); err != nil {
+		return err
+	}
+
+	for r, route := range node.Routes {
+		if r == len(node.Routes)-1 {
+			if err := printRouteTree(out, route, childPrefix+"└── ", childPrefix+"    "); err != nil {
+				return err
+			}
+		} else if err := printRouteTree(out, route, childPrefix+"├── ", childPrefix+"│   "); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func runRoutesTest(cmd *cobra.Command, args []string) error {
+	serverConfig, testConfig, err := loadConfig(cmd)
+	if err != nil {
+		return err
+	}
+	if serverConfig.Notifyer == nil {
+		return ErrMissingNotifyerConfig
+	}
+	tenantLabel := serverConfig.Alerts.TenantLabel
+	if tenantLabel == "" {
+		tenantLabel = configs.DefaultTenantLabel
+	}
+	flags := cmd.Flags()
+	fingerprint, err := flags.GetString("fingerprint")
+	if err != nil {
+		return err
+	}
+	expected, err := flags.GetStringSlice("expect")
+	if err != nil {
+		return err
+	}
+	notifyerConfig, err := notifyer.WithTenantConfigs(serverConfig.Alerts, serverConfig.Notifyer)
+	if err != nil {
+		return err
+	}
+	tree, err := notifyer.NewRouteTree(notifyerConfig.Route)
+	if err != nil {
+		return err
+	}
+
+	labels := api.LabelSet{}
+	for _, arg := range args {
+		name, value, found := strings.Cut(arg, "=")
+		if !found || name == "" {
+			return fmt.Errorf("%w: %s", ErrInvalidLabel, arg)
+		}
+		labels[name] = strings.Trim(value, `"`)
+	}
+	switch {
+	case fingerprint != "" && len(labels) == 0:
+		var alerts api.GettableAlerts
+		if alertsPath, err := flags.GetString("alerts"); err != nil {
+			return err
+		} else if alertsPath != "" {
+			if alerts, err = loadAlertsFile(alertsPath, tenantLabel); err != nil {
+				return err
+			}
+		} else {
+			alertClient, err := notifyer.NewAlertClient(cmd.Context(), serverConfig, testConfig, CommandNameRoutes)
+			if err != nil {
+				return err
+			}
+			liveAlerts, err := notifyer.GetAlerts(cmd.Context(), alertClient)
+			if err != nil {
+				return err
+			}
+			alerts = *liveAlerts
+		}
+		alert, err := notifyer.FindAlert(alerts, fingerprint)
+		if err != nil {
+			return err
+		}
+		labels = alert.Labels
+	case fingerprint != "" || len(labels) == 0:
+		return ErrNoLabels
+	}
+
+	result := notifyer.TestRoutes(tree, labels)
+	if err := printYaml(cmd, result); err != nil {
+		return err
+	}
+	if len(expected) > 0 {
+		receivers := make([]string, 0, len(result.Matches))
+		for _, match := range result.Matches {
+			receivers = append(receivers, match.Receiver)
+		}
+		if strings.Join(receivers, ",") != strings.Join(expected, ",") {
+			return fmt.Errorf("%w: %s", ErrRoutesTest, strings.Join(receivers, ","))
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,237 @@
+package cmd
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+
+	yaml "github.com/goccy/go-yaml"
+	prom_model "github.com/prometheus/common/model"
+	"github.com/spf13/cobra"
+
+	"github.com/pgillich/mimir-multitenant_alertmanager/configs"
+	notifyer "github.com/pgillich/mimir-multitenant_alertmanager/internal/notifyer"
+	api "github.com/pgillich/mimir-multitenant_alertmanager/pkg/api/notifyer"
+)
+
+const CommandNameSimulate = "simulate"
+
+var (
+	ErrNoSnapshots   = errors.New("either snapshot files or --scenario must be given")
+	ErrInvalidStep   = errors.New("invalid scenario step")
+	ErrInvalidOffset = errors.New("step offsets must be increasing")
+)
+
+var simulateCmd = &cobra.Command{ //nolint:gochecknoglobals // cobra
+	Use:   CommandNameSimulate + " [snapshot files...]",
+	Short: "Replay alert snapshots through the notifyer",
+	Long: `Replays a time-ordered sequence of alert snapshots through the notifyer logic with a virtual clock.
+A snapshot file is a GetAlerts JSON response or a capture file of the tenant alerts.
+The snapshots are evaluated at --start, then by --step (default: notifyer.pollPeriodSec).
+A scenario file (--scenario) describes the start, the step and the snapshots, for example:
+
+  start: 2024-12-13T19:30:00Z
+  step: 1m
+  steps:
+  - file: alerts-0.json
+  - at: 5m
+    alerts:
+    - labels: {alertname: DiskFull, tenant: devops}
+      endsAt: 2024-12-13T19:45:00Z
+
+The files are relative to the scenario file, the offsets (at) are relative to the start.
+An alert is resolved, if it's missing from the next snapshot and its endsAt is before the virtual time.
+A missing unresolved alert is resolved as disappeared after notifyer.disappearedGracePolls snapshots,
+except the alerts of the failedTenants of the step, which are kept.
+All receivers are in dry-run mode, so nothing is sent.
+The timeline of the notifications which would be sent is printed.`,
+	RunE: runSimulate,
+}
+
+func init() {
+	rootCmd.AddCommand(simulateCmd)
+	simulateCmd.Flags().String("scenario", "", "Scenario YAML file")
+	simulateCmd.Flags().String("start", "", "Virtual start time, RFC3339 (default: last update of the first snapshot)")
+	simulateCmd.Flags().Duration("step", 0, "Virtual time between the snapshots (default: notifyer.pollPeriodSec)")
+	simulateCmd.Flags().Bool("body", false, "Print the text and HTML bodies of the notifications")
+}
+
+// simulationScenario describes the snapshots of a simulation
+type simulationScenario struct {
+	Start string                   `yaml:"start"`
+	Step  string                   `yaml:"step"`
+	Steps []simulationScenarioStep `yaml:"steps"`
+}
+
+// simulationScenarioStep is a snapshot of a scenario, from a file or inline
+type simulationScenarioStep struct {
+	At     string             `yaml:"at"`
+	File   string             `yaml:"file"`
+	Alerts api.GettableAlerts `yaml:"alerts"`
+	// FailedTenants are the tenants, which can't be fetched at the step
+	FailedTenants []string `yaml:"failedTenants"`
+}
+
+func runSimulate(cmd *cobra.Command, args []string) error {
+	serverConfig, _, err := loadConfig(cmd)
+	if err != nil {
+		return err
+	}
+	if serverConfig.Notifyer == nil {
+		return ErrMissingNotifyerConfig
+	}
+	tenantLabel := serverConfig.Alerts.TenantLabel
+	if tenantLabel == "" {
+		tenantLabel = configs.DefaultTenantLabel
+	}
+	flags := cmd.Flags()
+	scenarioPath, err := flags.GetString("scenario")
+	if err != nil {
+		return err
+	}
+	start, err := flags.GetString("start")
+	if err != nil {
+		return err
+	}
+	step, err := flags.GetDuration("step")
+	if err != nil {
+		return err
+	}
+	body, err := flags.GetBool("body")
+	if err != nil {
+		return err
+	}
+
+	scenario := simulationScenario{}
+	scenarioDir := ""
+	switch {
+	case scenarioPath != "" && len(args) == 0:
+		content, err := os.ReadFile(scenarioPath) //nolint:gosec // file given by the user
+		if err != nil {
+			return err
+		}
+		if err := yaml.Unmarshal(content, &scenario); err != nil {
+			return err
+		}
+		scenarioDir = filepath.Dir(scenarioPath)
+	case scenarioPath == "" && len(args) > 0:
+		for _, arg := range args {
+			scenario.Steps = append(scenario.Steps, simulationScenarioStep{File: arg})
+		}
+	default:
+		return ErrNoSnapshots
+	}
+	if start != "" {
+		scenario.Start = start
+	}
+	if step != 0 {
+		scenario.Step = step.String()
+	} else if scenario.Step == "" {
+		scenario.Step = (time.Duration(serverConfig.Notifyer.PollPeriodSec) * time.Second).String()
+	}
+
+	steps, err := simulationSteps(scenario, scenarioDir, tenantLabel)
+	if err != nil {
+		return err
+	}
+	simulator, err := notifyer.NewSimulator(cmd.Context(), serverConfig.Alerts, serverConfig.Notifyer)
+	if err != nil {
+		return err
+	}
+	timeline, runErr := simulator.Run(cmd.Context(), steps)
+	if !body {
+		for t := range timeline {
+			for n := range timeline[t].Notifications {
+				timeline[t].Notifications[n].Text = ""
+				timeline[t].Notifications[n].Html = ""
+			}
+		}
+	}
+	if err := printYaml(cmd, timeline); err != nil {
+		return err
+	}
+
+	return runErr
+}
+
+// simulationSteps loads the snapshots of the scenario and calculates the virtual time of the steps
+func simulationSteps(scenario simulationScenario, scenarioDir string, tenantLabel string) ([]notifyer.SimulationStep, error) {
+	stepDuration, err := time.ParseDuration(scenario.Step)
+	if err != nil {
+		return nil, fmt.Errorf("%w: step: %w", ErrInvalidStep, err)
+	}
+
+	steps := make([]notifyer.SimulationStep, 0, len(scenario.Steps))
+	offsets := make([]time.Duration, 0, len(scenario.Steps))
+	offset := -stepDuration
+	for s, scenarioStep := range scenario.Steps {
+		step := notifyer.SimulationStep{
+			Alerts: scenarioStep.Alerts, FailedTenants: scenarioStep.FailedTenants, Source: fmt.Sprintf("steps[%d]", s),
+		}
+		if scenarioStep.File != "" {
+			path := scenarioStep.File
+			if scenarioDir != "" && !filepath.IsAbs(path) {
+				path = filepath.Join(scenarioDir, path)
+			}
+			alerts, err := loadAlertsFile(path, tenantLabel)
+			if err != nil {
+				return nil, fmt.Errorf("%w: steps[%d]: %w", ErrInvalidStep, s, err)
+			}
+			step.Alerts = append(alerts, step.Alerts...)
+			step.Source = scenarioStep.File
+		}
+
+		nextOffset := offset + stepDuration
+		if scenarioStep.At != "" {
+			at, err := prom_model.ParseDuration(scenarioStep.At)
+			if err != nil {
+				return nil, fmt.Errorf("%w: steps[%d].at: %w", ErrInvalidStep, s, err)
+			}
+			nextOffset = time.Duration(at)
+		}
+		if s > 0 && nextOffset <= offset {
+			return nil, fmt.Errorf("%w: steps[%d].at: %s", ErrInvalidOffset, s, scenarioStep.At)
+		}
+		offset = nextOffset
+		offsets = append(offsets, offset)
+		steps = append(steps, step)
+	}
+
+	start, err := simulationStart(scenario.Start, steps)
+	if err != nil {
+		return nil, err
+	}
+	for s := range steps {
+		steps[s].Time = start.Add(offsets[s])
+	}
+
+	return steps, nil
+}
+
+// simulationStart returns the start time of the scenario, the default is the last update of the first snapshot
+func simulationStart(start string, steps []notifyer.SimulationStep) (time.Time, error) {
+	if start != "" {
+		startTime, err := time.Parse(time.RFC3339, start)
+		if err != nil {
+			return time.Time{}, fmt.Errorf("%w: start: %w", ErrInvalidStep, err)
+		}
+
+		return startTime, nil
+	}
+
+	startTime := time.Time{}
+	if len(steps) > 0 {
+		for _, alert := range steps[0].Alerts {
+			if alert.UpdatedAt.After(startTime) {
+				startTime = alert.UpdatedAt
+			}
+		}
+	}
+	if startTime.IsZero() {
+		startTime = time.Now().Truncate(time.Second)
+	}
+
+	return startTime, nil
+}
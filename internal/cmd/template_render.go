@@ -0,0 +1,140 @@
+package cmd
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+
+	"github.com/spf13/cobra"
+
+	"github.com/pgillich/mimir-multitenant_alertmanager/configs"
+	notifyer "github.com/pgillich/mimir-multitenant_alertmanager/internal/notifyer"
+	api "github.com/pgillich/mimir-multitenant_alertmanager/pkg/api/notifyer"
+)
+
+const CommandNameTemplateRender = "template-render"
+
+var ErrMissingNotifyerConfig = errors.New("missing notifyer config")
+
+var templateRenderCmd = &cobra.Command{ //nolint:gochecknoglobals // cobra
+	Use:   CommandNameTemplateRender,
+	Short: "Render notification templates",
+	Long: `Renders the subject, text and HTML of the notification the way the email notifier does.
+The template sources (--template) and the message templates (--subject, --text, --html) override the configured ones.
+The alerts are read from a JSON file (GetAlerts response body) or from a capture file (--alerts),
+else the live alerts are fetched from the multi-tenant alerts API.
+Template errors are printed with line numbers and the command fails.`,
+	RunE: runTemplateRender,
+}
+
+func init() {
+	rootCmd.AddCommand(templateRenderCmd)
+	templateRenderCmd.Flags().StringSlice("template", nil, "Template source files (default: notifyer.templates)")
+	templateRenderCmd.Flags().String("receiver", "", "Receiver name (default: receiver of the root route)")
+	templateRenderCmd.Flags().String("subject", "", "Subject template file (default: Subject header of the email receiver)")
+	templateRenderCmd.Flags().String("text", "", "Text body template file (default: text of the email receiver)")
+	templateRenderCmd.Flags().String("html", "", "HTML body template file (default: HTML of the email receiver)")
+	templateRenderCmd.Flags().StringToString("group-label", nil, "Group labels")
+	templateRenderCmd.Flags().String("alerts", "", "Alerts JSON or capture file (default: live alerts)")
+	templateRenderCmd.Flags().String("html-out", "", "Write the rendered HTML to this file, for example to preview it in a browser")
+}
+
+func runTemplateRender(cmd *cobra.Command, args []string) error {
+	serverConfig, testConfig, err := loadConfig(cmd)
+	if err != nil {
+		return err
+	}
+	if serverConfig.Notifyer == nil {
+		return ErrMissingNotifyerConfig
+	}
+	tenantLabel := serverConfig.Alerts.TenantLabel
+	if tenantLabel == "" {
+		tenantLabel = configs.DefaultTenantLabel
+	}
+	flags := cmd.Flags()
+
+	request := api.TemplateRenderRequest{}
+	templatePaths, err := flags.GetStringSlice("template")
+	if err != nil {
+		return err
+	}
+	if len(templatePaths) > 0 {
+		sources := make([]api.TemplateSource, 0, len(templatePaths))
+		for _, templatePath := range templatePaths {
+			content, err := os.ReadFile(templatePath) //nolint:gosec // file given by the user
+			if err != nil {
+				return err
+			}
+			sources = append(sources, api.TemplateSource{Name: filepath.Base(templatePath), Content: string(content)})
+		}
+		request.Templates = &sources
+	}
+	if receiver, err := flags.GetString("receiver"); err != nil {
+		return err
+	} else if receiver != "" {
+		request.Receiver = &receiver
+	}
+	for flagName, value := range map[string]**string{"subject": &request.Subject, "text": &request.Text, "html": &request.Html} {
+		path, err := flags.GetString(flagName)
+		if err != nil {
+			return err
+		}
+		if path == "" {
+			continue
+		}
+		content, err := os.ReadFile(path) //nolint:gosec // file given by the user
+		if err != nil {
+			return err
+		}
+		contentStr := string(content)
+		*value = &contentStr
+	}
+	groupLabels, err := flags.GetStringToString("group-label")
+	if err != nil {
+		return err
+	}
+	if len(groupLabels) > 0 {
+		labelSet := api.LabelSet(groupLabels)
+		request.GroupLabels = &labelSet
+	}
+
+	var alerts api.GettableAlerts
+	if alertsPath, err := flags.GetString("alerts"); err != nil {
+		return err
+	} else if alertsPath != "" {
+		if alerts, err = loadAlertsFile(alertsPath, tenantLabel); err != nil {
+			return err
+		}
+	} else {
+		alertClient, err := notifyer.NewAlertClient(cmd.Context(), serverConfig, testConfig, CommandNameTemplateRender)
+		if err != nil {
+			return err
+		}
+		liveAlerts, err := notifyer.GetAlerts(cmd.Context(), alertClient)
+		if err != nil {
+			return err
+		}
+		alerts = *liveAlerts
+	}
+
+	render, templateErrors, err := notifyer.RenderTemplates(cmd.Context(), serverConfig.Alerts, serverConfig.Notifyer, request, alerts)
+	if errors.Is(err, notifyer.ErrTemplate) {
+		if printErr := printYaml(cmd, templateErrors); printErr != nil {
+			return printErr
+		}
+
+		return err
+	} else if err != nil {
+		return err
+	}
+
+	if htmlOut, err := flags.GetString("html-out"); err != nil {
+		return err
+	} else if htmlOut != "" {
+		if err := os.WriteFile(htmlOut, []byte(render.Html), 0o600); err != nil { //nolint:mnd // file mode
+			return err
+		}
+	}
+
+	return printYaml(cmd, render)
+}
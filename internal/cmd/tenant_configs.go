@@ -0,0 +1,155 @@
+package cmd
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	yaml "github.com/goccy/go-yaml"
+	"github.com/spf13/cobra"
+
+	"github.com/pgillich/mimir-multitenant_alertmanager/internal/alertmanager"
+	config_api "github.com/pgillich/mimir-multitenant_alertmanager/pkg/api/mimir_config"
+)
+
+const CommandNameTenantConfigs = "tenant-configs"
+
+var (
+	ErrConfigLint = errors.New("config lint failed")
+	ErrConfigPush = errors.New("config push failed")
+)
+
+var tenantConfigsCmd = &cobra.Command{ //nolint:gochecknoglobals // cobra
+	Use:   CommandNameTenantConfigs,
+	Short: "Tenant Alertmanager configurations",
+	Long: `Gets, lints and diffs the Alertmanager configurations of the tenants from Mimir.
+The diff is made against the baseline configuration (--baseline or alerts.configBaseline).
+With --push, the given configuration is validated and pushed to the selected tenants instead,
+if the push is enabled (alerts.configPush).
+The command fails, if a configuration has error findings or the push is failed.`,
+	RunE: runTenantConfigs,
+}
+
+func init() {
+	rootCmd.AddCommand(tenantConfigsCmd)
+	tenantConfigsCmd.Flags().StringSlice("tenant", nil, "Tenants (default: all configured tenants, push needs explicit tenants)")
+	tenantConfigsCmd.Flags().String("baseline", "", "Baseline Alertmanager configuration file (default: alerts.configBaseline)")
+	tenantConfigsCmd.Flags().String("push", "", "Alertmanager configuration file to push to the tenants")
+	tenantConfigsCmd.Flags().StringSlice("template", nil, "Template files to push with the configuration")
+}
+
+func runTenantConfigs(cmd *cobra.Command, args []string) error {
+	serverConfig, testConfig, err := loadConfig(cmd)
+	if err != nil {
+		return err
+	}
+	flags := cmd.Flags()
+	selected, err := flags.GetStringSlice("tenant")
+	if err != nil {
+		return err
+	}
+	baselinePath, err := flags.GetString("baseline")
+	if err != nil {
+		return err
+	}
+	if baselinePath == "" {
+		baselinePath = serverConfig.Alerts.ConfigBaseline
+	}
+	pushPath, err := flags.GetString("push")
+	if err != nil {
+		return err
+	}
+	templatePaths, err := flags.GetStringSlice("template")
+	if err != nil {
+		return err
+	}
+
+	tenantConfigs, err := alertmanager.NewTenantConfigs(cmd.Context(), serverConfig.Alerts, testConfig, CommandNameTenantConfigs)
+	if err != nil {
+		return err
+	}
+	tenants, err := tenantConfigs.SelectTenants(selected)
+	if err != nil {
+		return err
+	}
+
+	if pushPath != "" {
+		if len(selected) == 0 {
+			return alertmanager.ErrNoTenants
+		}
+
+		return pushTenantConfigs(cmd, tenantConfigs, tenants, pushPath, templatePaths)
+	}
+
+	baseline, err := alertmanager.LoadConfigBaseline(baselinePath)
+	if err != nil {
+		return err
+	}
+	report, err := tenantConfigs.Report(cmd.Context(), tenants, baseline)
+	if err != nil {
+		return err
+	}
+	if err := printYaml(cmd, report); err != nil {
+		return err
+	}
+	for _, tenantConfig := range report {
+		if alertmanager.HasConfigError(tenantConfig.Findings) {
+			return ErrConfigLint
+		}
+	}
+
+	return nil
+}
+
+func pushTenantConfigs(cmd *cobra.Command, tenantConfigs *alertmanager.TenantConfigs, tenants []string,
+	pushPath string, templatePaths []string,
+) error {
+	amConfig, err := os.ReadFile(pushPath)
+	if err != nil {
+		return err
+	}
+	userConfig := &config_api.UserConfig{AlertmanagerConfig: string(amConfig)}
+	if len(templatePaths) > 0 {
+		templateFiles := map[string]string{}
+		for _, templatePath := range templatePaths {
+			content, err := os.ReadFile(templatePath)
+			if err != nil {
+				return err
+			}
+			templateFiles[filepath.Base(templatePath)] = string(content)
+		}
+		userConfig.TemplateFiles = &templateFiles
+	}
+
+	results, findings, err := tenantConfigs.Push(cmd.Context(), tenants, userConfig)
+	if errors.Is(err, alertmanager.ErrInvalidConfig) {
+		if printErr := printYaml(cmd, findings); printErr != nil {
+			return printErr
+		}
+
+		return ErrConfigLint
+	} else if err != nil {
+		return err
+	}
+	if err := printYaml(cmd, results); err != nil {
+		return err
+	}
+	for _, result := range results {
+		if !result.Pushed {
+			return ErrConfigPush
+		}
+	}
+
+	return nil
+}
+
+func printYaml(cmd *cobra.Command, value any) error {
+	out, err := yaml.MarshalWithOptions(value, yaml.UseLiteralStyleIfMultiline(true))
+	if err != nil {
+		return err
+	}
+	_, err = fmt.Fprint(cmd.OutOrStdout(), string(out))
+
+	return err
+}
@@ -1,10 +1,16 @@
 package alertmanager
 
 import (
+	"encoding/json"
 	"errors"
+	"fmt"
 	"maps"
 	"net/http"
 	"slices"
+	"strconv"
+	"time"
+
+	"github.com/prometheus/alertmanager/pkg/labels"
 
 	"github.com/pgillich/micro-server/pkg/logger"
 
@@ -19,6 +25,11 @@ var (
 	ErrAlertmanagerResponse  = errors.New("alertmanager response")
 	ErrInvalidResponseStatus = errors.New("invalid response status")
 	ErrRenderResponse        = errors.New("unable to render response")
+	ErrInvalidRequest        = errors.New("invalid request")
+)
+
+const (
+	eventKeepAlivePeriod = 30 * time.Second
 )
 
 func (s *ApiServer) GetAlerts(w http.ResponseWriter, r *http.Request, params api.GetAlertsParams) {
@@ -29,3 +40,96 @@ func (s *ApiServer) GetAlerts(w http.ResponseWriter, r *http.Request, params api
 		log.Error("Unable to render response", logger.KeyError, logger.Wrap(ErrRenderResponse, err))
 	}
 }
+
+func (s *ApiServer) GetAlertEvents(w http.ResponseWriter, r *http.Request, params api.GetAlertEventsParams) {
+	_, log := logger.FromContext(r.Context())
+	matchers := labels.Matchers{}
+	if params.Filter != nil {
+		for _, filter := range *params.Filter {
+			matcher, err := labels.ParseMatcher(filter)
+			if err != nil {
+				log.Error("Unable to parse filter", logger.KeyError, err)
+				if err = api.GetAlertEvents400JSONResponse(logger.Wrap(ErrInvalidRequest, err).Error()).VisitGetAlertEventsResponse(w); err != nil {
+					log.Error("Unable to render error response", logger.KeyError, logger.Wrap(ErrRenderResponse, err))
+				}
+				return
+			}
+			matchers = append(matchers, matcher)
+		}
+	}
+	lastEventID := uint64(0)
+	if params.LastEventID != nil && *params.LastEventID != "" {
+		var err error
+		if lastEventID, err = strconv.ParseUint(*params.LastEventID, 10, 64); err != nil {
+			log.Error("Unable to parse Last-Event-ID", logger.KeyError, err)
+			if err = api.GetAlertEvents400JSONResponse(logger.Wrap(ErrInvalidRequest, err).Error()).VisitGetAlertEventsResponse(w); err != nil {
+				log.Error("Unable to render error response", logger.KeyError, logger.Wrap(ErrRenderResponse, err))
+			}
+			return
+		}
+	}
+
+	backlog, events, unsubscribe := s.service.notify.events.Subscribe(lastEventID)
+	defer unsubscribe()
+
+	w.Header().Set("Content-Type", "text/event-stream")
+	w.Header().Set("Cache-Control", "no-cache")
+	w.Header().Set("Connection", "keep-alive")
+	w.WriteHeader(http.StatusOK)
+	controller := http.NewResponseController(w)
+
+	for _, event := range backlog {
+		if err := writeAlertEvent(w, matchers, event); err != nil {
+			log.Info("Alert event stream closed", logger.KeyError, err)
+			return
+		}
+	}
+	// If the response writer can't be flushed, the stream is closed after the first written events,
+	// so the client gets them and resumes the stream by Last-Event-ID.
+	if err := controller.Flush(); err != nil && (len(backlog) > 0 || !errors.Is(err, http.ErrNotSupported)) {
+		log.Info("Alert event stream closed", logger.KeyError, err)
+		return
+	}
+
+	keepAlive := time.NewTicker(eventKeepAlivePeriod)
+	defer keepAlive.Stop()
+	for {
+		select {
+		case <-r.Context().Done():
+			return
+		case <-s.service.shutdown:
+			return
+		case <-keepAlive.C:
+			if _, err := fmt.Fprint(w, ": keep-alive\n\n"); err != nil {
+				log.Info("Alert event stream closed", logger.KeyError, err)
+				return
+			}
+		case event, open := <-events:
+			if !open {
+				log.Warn("Alert event stream closed, the subscriber is too slow, it can resume by Last-Event-ID")
+				return
+			}
+			if err := writeAlertEvent(w, matchers, event); err != nil {
+				log.Info("Alert event stream closed", logger.KeyError, err)
+				return
+			}
+		}
+		if err := controller.Flush(); err != nil {
+			log.Info("Alert event stream closed", logger.KeyError, err)
+			return
+		}
+	}
+}
+
+func writeAlertEvent(w http.ResponseWriter, matchers labels.Matchers, event api.AlertEvent) error {
+	if !MatchAlertEvent(matchers, event) {
+		return nil
+	}
+	data, err := json.Marshal(event)
+	if err != nil {
+		return err
+	}
+	_, err = fmt.Fprintf(w, "id: %d\nevent: alert\ndata: %s\n\n", event.Id, data)
+
+	return err
+}
@@ -0,0 +1,164 @@
+package alertmanager
+
+import (
+	html_tmpl "html/template"
+	"maps"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+	text_tmpl "text/template"
+	"time"
+
+	prom_model "github.com/prometheus/common/model"
+
+	"github.com/pgillich/mimir-multitenant_alertmanager/configs"
+	api "github.com/pgillich/mimir-multitenant_alertmanager/pkg/api/notifyer"
+)
+
+const (
+	// ChangedAnnotation is the comma-separated list of the changed label and annotation keys of a change notification
+	ChangedAnnotation = "changed"
+	// PreviousAnnotationPrefix is the prefix of the annotations with the previous values of the changed keys
+	PreviousAnnotationPrefix = "previous_"
+
+	SuppressReasonChangeRateLimit = "change_rate_limit"
+)
+
+// AlertChange is a changed label or annotation value of an alert, see the alertChanges template function
+type AlertChange struct {
+	Key string
+	Old string
+	New string
+}
+
+// ChangeDetector detects the changes of the configured labels and annotations of the alerts
+// and limits the change notifications of the alert groups, see configs.ChangeDetectionConfig
+type ChangeDetector struct {
+	mu       sync.Mutex
+	config   configs.ChangeDetectionConfig
+	notified map[string]time.Time
+}
+
+func NewChangeDetector(changeDetection configs.ChangeDetectionConfig) *ChangeDetector {
+	return &ChangeDetector{config: changeDetection, notified: map[string]time.Time{}}
+}
+
+// Changed compares the configured labels and annotations of the alerts,
+// the alert is returned with the changed and previous annotations, if a value is changed
+func (d *ChangeDetector) Changed(oldAlert api.GettableAlert, alert api.GettableAlert) (api.GettableAlert, bool) {
+	changed := []string{}
+	previous := map[string]string{}
+	for _, key := range d.config.Labels {
+		if oldAlert.Labels[key] != alert.Labels[key] {
+			changed = append(changed, key)
+			previous[key] = oldAlert.Labels[key]
+		}
+	}
+	for _, key := range d.config.Annotations {
+		if _, has := previous[key]; !has && oldAlert.Annotations[key] != alert.Annotations[key] {
+			changed = append(changed, key)
+			previous[key] = oldAlert.Annotations[key]
+		}
+	}
+	if len(changed) == 0 {
+		return alert, false
+	}
+
+	alert.Annotations = maps.Clone(alert.Annotations)
+	if alert.Annotations == nil {
+		alert.Annotations = api.LabelSet{}
+	}
+	alert.Annotations[ChangedAnnotation] = strings.Join(changed, ",")
+	for key, value := range previous {
+		alert.Annotations[PreviousAnnotationPrefix+key] = value
+	}
+
+	return alert, true
+}
+
+// Relabeled returns the missing alerts by their labels without the configured labels,
+// so the alerts with changed labels can be matched to them, see Key
+func (d *ChangeDetector) Relabeled(lastAlerts map[string]api.GettableAlert, alerts api.GettableAlerts) map[string]api.GettableAlert {
+	relabeled := map[string]api.GettableAlert{}
+	if len(d.config.Labels) == 0 {
+		return relabeled
+	}
+	present := map[string]bool{}
+	for _, alert := range alerts {
+		present[alert.Fingerprint] = true
+	}
+	for _, fingerprint := range slices.Sorted(maps.Keys(lastAlerts)) {
+		if !present[fingerprint] {
+			relabeled[d.Key(lastAlerts[fingerprint])] = lastAlerts[fingerprint]
+		}
+	}
+
+	return relabeled
+}
+
+// Key is the signature of the labels of the alert without the configured labels
+func (d *ChangeDetector) Key(alert api.GettableAlert) string {
+	labels := maps.Clone(alert.Labels)
+	for _, key := range d.config.Labels {
+		delete(labels, key)
+	}
+
+	return strconv.FormatUint(prom_model.LabelsToSignature(labels), 16)
+}
+
+// Admit checks the minimum interval of the change notifications of the group.
+// A group with other than changed alerts is always admitted.
+func (d *ChangeDetector) Admit(now time.Time, group *alertGroup) bool {
+	changes := 0
+	for _, alert := range group.alerts {
+		if alert.Annotations[ChangedAnnotation] != "" {
+			changes++
+		}
+	}
+	if changes == 0 {
+		return true
+	}
+
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	key := group.receiver + "/" + group.groupKey
+	minInterval := time.Duration(d.config.MinIntervalSec) * time.Second
+	if last, has := d.notified[key]; has && changes == len(group.alerts) && now.Sub(last) < minInterval {
+		return false
+	}
+	d.notified[key] = now
+
+	return true
+}
+
+// registerChanges registers the alertChanges template function, which returns the changes of an alert,
+// for example: {{ range alertChanges . }}{{ .Key }} changed from {{ .Old }} to {{ .New }}{{ end }}
+func registerChanges(text *text_tmpl.Template, html *html_tmpl.Template) {
+	alertChanges := func(alert any) []AlertChange {
+		templateAlert := templateAlert(alert)
+		changes := []AlertChange{}
+		if templateAlert.Annotations[ChangedAnnotation] == "" {
+			return changes
+		}
+		for _, key := range strings.Split(templateAlert.Annotations[ChangedAnnotation], ",") {
+			change := AlertChange{Key: key, Old: templateAlert.Annotations[PreviousAnnotationPrefix+key]}
+			if value, has := templateAlert.Labels[key]; has {
+				change.New = value
+			} else {
+				change.New = templateAlert.Annotations[key]
+			}
+			changes = append(changes, change)
+		}
+
+		return changes
+	}
+
+	text.Funcs(text_tmpl.FuncMap{"alertChanges": alertChanges})
+	html.Funcs(html_tmpl.FuncMap{"alertChanges": alertChanges})
+}
+
+// alertFiring checks, if the alert is active at now
+func alertFiring(alert api.GettableAlert, now time.Time) bool {
+	return AlertStateAt(alert, now) == string(api.Active)
+}
@@ -0,0 +1,147 @@
+package alertmanager
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/pgillich/micro-server/pkg/logger"
+)
+
+var ErrInvalidSchedule = errors.New("invalid schedule")
+
+// cronMacros are the supported shorthands of the cron expressions
+var cronMacros = map[string]string{
+	"@hourly":  "0 * * * *",
+	"@daily":   "0 0 * * *",
+	"@weekly":  "0 0 * * 0",
+	"@monthly": "0 0 1 * *",
+}
+
+// cronSchedule is a parsed cron expression with minute precision.
+// The day-of-month and day-of-week fields are OR-ed, if both are restricted, like in the classic cron.
+type cronSchedule struct {
+	minutes     map[int]bool
+	hours       map[int]bool
+	daysOfMonth map[int]bool
+	months      map[int]bool
+	daysOfWeek  map[int]bool
+	anyDom      bool
+	anyDow      bool
+	location    *time.Location
+}
+
+type cronField struct {
+	name     string
+	min, max int
+}
+
+// parseSchedule parses a cron expression (minute hour day-of-month month day-of-week) or a macro.
+// The fields may contain *, values, ranges, lists and steps, for example: "0 8,16 * * 1-5" or "*/30 * * * *"
+func parseSchedule(expression string, location string) (*cronSchedule, error) {
+	loc, err := time.LoadLocation(location)
+	if err != nil {
+		return nil, logger.Wrap(ErrInvalidSchedule, err)
+	}
+	if macro, has := cronMacros[strings.TrimSpace(expression)]; has {
+		expression = macro
+	}
+	fields := strings.Fields(expression)
+	if len(fields) != 5 {
+		return nil, logger.Wrap(ErrInvalidSchedule, fmt.Errorf("expected 5 fields, got %d in %q", len(fields), expression))
+	}
+
+	schedule := &cronSchedule{location: loc, anyDom: fields[2] == "*", anyDow: fields[4] == "*"}
+	for f, target := range []*map[int]bool{
+		&schedule.minutes, &schedule.hours, &schedule.daysOfMonth, &schedule.months, &schedule.daysOfWeek,
+	} {
+		field := []cronField{{"minute", 0, 59}, {"hour", 0, 23}, {"day-of-month", 1, 31}, {"month", 1, 12}, {"day-of-week", 0, 7}}[f]
+		if *target, err = parseCronField(fields[f], field); err != nil {
+			return nil, logger.Wrap(ErrInvalidSchedule, err)
+		}
+	}
+	if schedule.daysOfWeek[7] {
+		schedule.daysOfWeek[0] = true
+	}
+	if schedule.Next(time.Date(2000, 1, 1, 0, 0, 0, 0, loc)).IsZero() {
+		return nil, logger.Wrap(ErrInvalidSchedule, fmt.Errorf("%q never matches", expression))
+	}
+
+	return schedule, nil
+}
+
+func parseCronField(value string, field cronField) (map[int]bool, error) {
+	values := map[int]bool{}
+	for _, item := range strings.Split(value, ",") {
+		rangeValue, stepValue, hasStep := strings.Cut(item, "/")
+		step := 1
+		if hasStep {
+			var err error
+			if step, err = strconv.Atoi(stepValue); err != nil || step <= 0 {
+				return nil, fmt.Errorf("invalid step %q of %s", stepValue, field.name)
+			}
+		}
+		from, to := field.min, field.max
+		if rangeValue != "*" {
+			fromValue, toValue, isRange := strings.Cut(rangeValue, "-")
+			var err error
+			if from, err = strconv.Atoi(fromValue); err != nil {
+				return nil, fmt.Errorf("invalid %s %q", field.name, item)
+			}
+			to = from
+			if isRange {
+				if to, err = strconv.Atoi(toValue); err != nil {
+					return nil, fmt.Errorf("invalid %s %q", field.name, item)
+				}
+			} else if hasStep {
+				to = field.max
+			}
+		}
+		if from < field.min || to > field.max || from > to {
+			return nil, fmt.Errorf("%s %q out of range %d-%d", field.name, item, field.min, field.max)
+		}
+		for v := from; v <= to; v += step {
+			values[v] = true
+		}
+	}
+
+	return values, nil
+}
+
+// Next returns the first scheduled time after the given time, or zero time, if there is none in 5 years
+func (s *cronSchedule) Next(after time.Time) time.Time {
+	t := after.In(s.location).Truncate(time.Minute).Add(time.Minute)
+	limit := t.AddDate(5, 0, 0)
+	for t.Before(limit) {
+		switch {
+		case !s.months[int(t.Month())]:
+			t = time.Date(t.Year(), t.Month()+1, 1, 0, 0, 0, 0, s.location)
+		case !s.matchDay(t):
+			t = time.Date(t.Year(), t.Month(), t.Day()+1, 0, 0, 0, 0, s.location)
+		case !s.hours[t.Hour()]:
+			t = time.Date(t.Year(), t.Month(), t.Day(), t.Hour()+1, 0, 0, 0, s.location)
+		case !s.minutes[t.Minute()]:
+			t = t.Add(time.Minute)
+		default:
+			return t
+		}
+	}
+
+	return time.Time{}
+}
+
+func (s *cronSchedule) matchDay(t time.Time) bool {
+	dom, dow := s.daysOfMonth[t.Day()], s.daysOfWeek[int(t.Weekday())]
+	switch {
+	case s.anyDom && s.anyDow:
+		return true
+	case s.anyDom:
+		return dow
+	case s.anyDow:
+		return dom
+	default:
+		return dom || dow
+	}
+}
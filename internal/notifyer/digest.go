@@ -0,0 +1,254 @@
+package alertmanager
+
+import (
+	"cmp"
+	"context"
+	"errors"
+	"fmt"
+	html_tmpl "html/template"
+	"log/slog"
+	"maps"
+	"slices"
+	"sync"
+	text_tmpl "text/template"
+	"time"
+
+	am_config "github.com/prometheus/alertmanager/config"
+	"github.com/prometheus/alertmanager/dispatch"
+	"github.com/prometheus/alertmanager/notify"
+	"github.com/prometheus/alertmanager/template"
+	prom_model "github.com/prometheus/common/model"
+
+	"github.com/pgillich/micro-server/pkg/logger"
+
+	"github.com/pgillich/mimir-multitenant_alertmanager/configs"
+	api "github.com/pgillich/mimir-multitenant_alertmanager/pkg/api/notifyer"
+)
+
+const (
+	// DigestTemplateName is the built-in HTML body template of the digests
+	DigestTemplateName = "digest.default.html"
+	// DigestSubjectTemplateName is the built-in subject template of the digests
+	DigestSubjectTemplateName = "digest.default.subject"
+)
+
+// digestTemplates are the built-in digest templates, which can be overridden by the notifyer templates
+const digestTemplates = `
+{{ define "digest.default.subject" }}[DIGEST] {{ .Receiver }}: {{ len .Alerts.Firing }} firing, {{ len .Alerts.Resolved }} resolved{{ end }}
+
+{{ define "digest.default.html" }}<h2>{{ template "digest.default.subject" . }}</h2>
+{{ range digestGroups .Alerts }}<h3>{{ .Tenant }} / {{ .Severity }}</h3>
+{{ if .Firing }}<p>Firing:</p>
+<ul>{{ range .Firing }}<li>{{ .Labels.alertname }} since {{ .StartsAt.Format "2006-01-02 15:04 MST" }}{{ with .Annotations.summary }}: {{ . }}{{ end }}</li>{{ end }}</ul>
+{{ end }}{{ if .Resolved }}<p>Resolved:</p>
+<ul>{{ range .Resolved }}<li>{{ .Labels.alertname }} at {{ .EndsAt.Format "2006-01-02 15:04 MST" }}{{ with .Annotations.summary }}: {{ . }}{{ end }}</li>{{ end }}</ul>
+{{ end }}{{ end }}{{ end }}
+`
+
+// DigestGroup is the alerts of a tenant with the same severity, see the digestGroups template function
+type DigestGroup struct {
+	Tenant   string
+	Severity string
+	Firing   template.Alerts
+	Resolved template.Alerts
+}
+
+// Digester collects the alerts of the digest receivers and returns the digests at the scheduled times.
+// A digest has the firing alerts and the alerts, which were fired and resolved since the last digest.
+type Digester struct {
+	mu      sync.Mutex
+	digests map[string]*digestState
+}
+
+type digestState struct {
+	schedule *cronSchedule
+	nextAt   time.Time
+	// alerts are fired since the last digest, by fingerprint
+	alerts map[string]api.GettableAlert
+}
+
+func NewDigester(digests []configs.DigestConfig) (*Digester, error) {
+	d := &Digester{digests: map[string]*digestState{}}
+	for _, digest := range digests {
+		schedule, err := parseSchedule(digest.Schedule, digest.Location)
+		if err != nil {
+			return nil, logger.Wrap(ErrInvalidSchedule, fmt.Errorf("digest of %s: %w", digest.Receiver, err))
+		}
+		d.digests[digest.Receiver] = &digestState{schedule: schedule, alerts: map[string]api.GettableAlert{}}
+	}
+
+	return d, nil
+}
+
+// Has checks, if the receiver is a digest receiver
+func (d *Digester) Has(receiver string) bool {
+	_, has := d.digests[receiver]
+
+	return has
+}
+
+// Collect tracks the firing alerts of the digest receivers and returns the digests, which are due at now.
+// The disappeared alerts are resolved at now. An empty digest is not returned.
+// A digest is due until it's Sent, so a failed digest is returned again at the next evaluation.
+func (d *Digester) Collect(ctx context.Context, now time.Time, tree *dispatch.Route, alerts map[string]api.GettableAlert,
+) []alertGroup {
+	_, log := logger.FromContext(ctx)
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	groups := []alertGroup{}
+	for _, receiver := range slices.Sorted(maps.Keys(d.digests)) {
+		state := d.digests[receiver]
+		if state.nextAt.IsZero() {
+			state.nextAt = state.schedule.Next(now)
+		}
+		for fingerprint, alert := range alerts {
+			if _, has := state.alerts[fingerprint]; has {
+				state.alerts[fingerprint] = alert
+			} else if AlertStateAt(alert, now) == string(api.Active) && routedTo(tree, alert, receiver) {
+				state.alerts[fingerprint] = alert
+			}
+		}
+		for fingerprint, alert := range state.alerts {
+			if _, has := alerts[fingerprint]; !has && AlertStateAt(alert, now) != AlertStateResolved {
+				alert.EndsAt = now
+				state.alerts[fingerprint] = alert
+			}
+		}
+		if now.Before(state.nextAt) {
+			continue
+		}
+
+		group := alertGroup{receiver: receiver, groupKey: "digest:" + receiver, groupLabels: prom_model.LabelSet{}}
+		for _, fingerprint := range slices.Sorted(maps.Keys(state.alerts)) {
+			alert := state.alerts[fingerprint]
+			switch AlertStateAt(alert, now) {
+			case AlertStateResolved, string(api.Active):
+			default: // silenced or inhibited
+				continue
+			}
+			group.alerts = append(group.alerts, ApiAlertToPromAlert(alert))
+		}
+		log.Info("Digest", "receiver", receiver, "alerts", len(group.alerts), "scheduledAt", state.nextAt)
+		if len(group.alerts) == 0 {
+			state.nextAt = state.schedule.Next(now)
+
+			continue
+		}
+		groups = append(groups, group)
+	}
+
+	return groups
+}
+
+// Sent drops the resolved alerts of the sent digest of the receiver and schedules the next digest
+func (d *Digester) Sent(receiver string, now time.Time) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	state := d.digests[receiver]
+	for fingerprint, alert := range state.alerts {
+		if AlertStateAt(alert, now) == AlertStateResolved {
+			delete(state.alerts, fingerprint)
+		}
+	}
+	state.nextAt = state.schedule.Next(now)
+}
+
+// routedTo checks, if the alert is routed to the receiver
+func routedTo(tree *dispatch.Route, alert api.GettableAlert, receiver string) bool {
+	return slices.ContainsFunc(tree.Match(ApiAlertToPromAlert(alert).Labels), func(route *dispatch.Route) bool {
+		return route.RouteOpts.Receiver == receiver
+	})
+}
+
+// digest sends the due digests, a failed digest is sent again at the next evaluation
+func (n *Notify) digest(ctx context.Context, now time.Time, alerts map[string]api.GettableAlert) error {
+	var errs []error
+	for _, group := range n.digests.Collect(ctx, now, n.routeTree, alerts) {
+		if err := n.notifyIntegrations(ctx, &group, n.templates.Load().digestNotifiers[group.receiver]); err != nil {
+			errs = append(errs, err)
+
+			continue
+		}
+		n.digests.Sent(group.receiver, now)
+	}
+
+	return errors.Join(errs...)
+}
+
+// newDigestNotifiers creates the email notifiers of the digest receivers, which render the digest templates
+func newDigestNotifiers(log *slog.Logger, notifyerConfig *configs.NotifyerConfig, tmpl *template.Template,
+	dryRunLog *DryRunLog, goKitLog *GoKitAdapter,
+) map[string][]notify.Notifier {
+	digestConfig := *notifyerConfig
+	digestConfig.Receivers = []am_config.Receiver{}
+	for _, digest := range notifyerConfig.Digests {
+		for _, receiver := range notifyerConfig.Receivers {
+			if receiver.Name == digest.Receiver {
+				digestConfig.Receivers = append(digestConfig.Receivers, templatedReceiver(receiver,
+					cmp.Or(digest.Template, DigestTemplateName),
+					cmp.Or(digest.Subject, `{{ template "`+DigestSubjectTemplateName+`" . }}`)))
+			}
+		}
+	}
+
+	return newNotifiers(log, &digestConfig, tmpl, dryRunLog, goKitLog)
+}
+
+// templatedReceiver returns the receiver with the email configs rendering the HTML template and the subject
+func templatedReceiver(receiver am_config.Receiver, templateName string, subject string) am_config.Receiver {
+	emailConfigs := make([]*am_config.EmailConfig, 0, len(receiver.EmailConfigs))
+	for _, emailConfig := range receiver.EmailConfigs {
+		templatedEmail := *emailConfig
+		templatedEmail.Headers = maps.Clone(emailConfig.Headers)
+		if templatedEmail.Headers == nil {
+			templatedEmail.Headers = map[string]string{}
+		}
+		templatedEmail.Headers["Subject"] = subject
+		templatedEmail.HTML = `{{ template "` + templateName + `" . }}`
+		templatedEmail.Text = ""
+		emailConfigs = append(emailConfigs, &templatedEmail)
+	}
+	receiver.EmailConfigs = emailConfigs
+
+	return receiver
+}
+
+// registerDigest registers the digestGroups template function, which groups the alerts by tenant and severity,
+// for example: {{ range digestGroups .Alerts }}{{ .Tenant }} {{ .Severity }} {{ len .Firing }}{{ end }}
+func registerDigest(alertsConfig *configs.AlertsConfig) template.Option {
+	tenantLabel := configs.DefaultTenantLabel
+	if alertsConfig != nil && alertsConfig.TenantLabel != "" {
+		tenantLabel = alertsConfig.TenantLabel
+	}
+	digestGroups := func(alerts any) []DigestGroup {
+		groups := map[[2]string]*DigestGroup{}
+		for _, alert := range templateAlerts(alerts) {
+			key := [2]string{alert.Labels[tenantLabel], alert.Labels["severity"]}
+			group, has := groups[key]
+			if !has {
+				group = &DigestGroup{Tenant: key[0], Severity: key[1], Firing: template.Alerts{}, Resolved: template.Alerts{}}
+				groups[key] = group
+			}
+			if alert.Status == string(prom_model.AlertResolved) {
+				group.Resolved = append(group.Resolved, alert)
+			} else {
+				group.Firing = append(group.Firing, alert)
+			}
+		}
+		digestGroups := make([]DigestGroup, 0, len(groups))
+		for _, key := range slices.SortedFunc(maps.Keys(groups), func(a, b [2]string) int {
+			return cmp.Or(cmp.Compare(a[0], b[0]), cmp.Compare(a[1], b[1]))
+		}) {
+			digestGroups = append(digestGroups, *groups[key])
+		}
+
+		return digestGroups
+	}
+
+	return func(text *text_tmpl.Template, html *html_tmpl.Template) {
+		text.Funcs(text_tmpl.FuncMap{"digestGroups": digestGroups})
+		html.Funcs(html_tmpl.FuncMap{"digestGroups": digestGroups})
+	}
+}
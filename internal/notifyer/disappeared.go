@@ -0,0 +1,50 @@
+package alertmanager
+
+import (
+	"context"
+	"maps"
+	"slices"
+	"time"
+
+	"github.com/pgillich/micro-server/pkg/logger"
+
+	api "github.com/pgillich/mimir-multitenant_alertmanager/pkg/api/notifyer"
+)
+
+// DisappearedAnnotation marks the alerts, which are missing from the alerts API without being resolved
+const DisappearedAnnotation = "disappeared"
+
+// disappeared applies the policy of a missing unresolved alert.
+// The alert is kept, if its tenant can't be fetched or it's missing for not more than DisappearedGracePolls polls,
+// else it's returned as gone: resolved now, with the disappeared annotation.
+func (n *Notify) disappeared(ctx context.Context, now time.Time, alert api.GettableAlert, failedTenants []string,
+) (api.GettableAlert, bool) {
+	_, log := logger.FromContext(ctx)
+	if tenant := alert.Labels[n.tenantLabel]; slices.Contains(failedTenants, tenant) {
+		log.Warn("ALERT_KEPT", "tenant", tenant, "fingerprint", alert.Fingerprint, "alert", alert.Labels)
+
+		return alert, false
+	}
+	if polls := n.missing[alert.Fingerprint] + 1; polls <= n.config.DisappearedGracePolls {
+		log.Info("ALERT_MISSING", "fingerprint", alert.Fingerprint, "alert", alert.Labels, "polls", polls)
+		n.missing[alert.Fingerprint] = polls
+
+		return alert, false
+	}
+	log.Warn("ALERT_DISAPPEARED", "fingerprint", alert.Fingerprint, "alert", alert.Labels, "endsAt", alert.EndsAt)
+	delete(n.missing, alert.Fingerprint)
+
+	return markDisappeared(alert, now), true
+}
+
+// markDisappeared returns a copy of the alert, resolved at now, with the disappeared annotation
+func markDisappeared(alert api.GettableAlert, now time.Time) api.GettableAlert {
+	alert.Annotations = maps.Clone(alert.Annotations)
+	if alert.Annotations == nil {
+		alert.Annotations = api.LabelSet{}
+	}
+	alert.Annotations[DisappearedAnnotation] = "true"
+	alert.EndsAt = now
+
+	return alert
+}
@@ -0,0 +1,179 @@
+package alertmanager
+
+import (
+	"context"
+	"log/slog"
+	"net/http"
+	"sync"
+	"time"
+
+	am_config "github.com/prometheus/alertmanager/config"
+	"github.com/prometheus/alertmanager/notify"
+	"github.com/prometheus/alertmanager/template"
+	am_types "github.com/prometheus/alertmanager/types"
+	prom_model "github.com/prometheus/common/model"
+
+	"github.com/pgillich/micro-server/pkg/logger"
+
+	api "github.com/pgillich/mimir-multitenant_alertmanager/pkg/api/notifyer"
+)
+
+const DefaultDryRunLogSize = 100
+
+// DryRunLog keeps the last notifications of the receivers in dry-run mode in a ring buffer
+type DryRunLog struct {
+	mu     sync.Mutex
+	lastID uint64
+	ring   []api.DryRunNotification
+	next   int
+}
+
+func NewDryRunLog(size int) *DryRunLog {
+	if size <= 0 {
+		size = DefaultDryRunLogSize
+	}
+
+	return &DryRunLog{
+		ring: make([]api.DryRunNotification, 0, size),
+	}
+}
+
+// Add sets the ID of the notification and stores it, the oldest notification is dropped, if the log is full
+func (l *DryRunLog) Add(notification api.DryRunNotification) api.DryRunNotification {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	l.lastID++
+	notification.Id = l.lastID
+	if len(l.ring) < cap(l.ring) {
+		l.ring = append(l.ring, notification)
+	} else {
+		l.ring[l.next] = notification
+		l.next = (l.next + 1) % cap(l.ring)
+	}
+
+	return notification
+}
+
+// List returns the notifications of the receiver (all, if empty), the oldest is the first
+func (l *DryRunLog) List(receiver string) []api.DryRunNotification {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	notifications := []api.DryRunNotification{}
+	for i := range l.ring {
+		notification := l.ring[(l.next+i)%len(l.ring)]
+		if receiver == "" || notification.Receiver == receiver {
+			notifications = append(notifications, notification)
+		}
+	}
+
+	return notifications
+}
+
+// DryRunEmail renders the email the same way as the email notifier does, but records it in the dry-run log instead of sending.
+// If grafana is set, the message templates are executed on the Grafana template data, like by ExtendedEmail.
+type DryRunEmail struct {
+	conf        *am_config.EmailConfig
+	tmpl        *template.Template
+	integration string
+	grafana     bool
+	log         *DryRunLog
+}
+
+func NewDryRunEmail(conf *am_config.EmailConfig, tmpl *template.Template, integration string, grafana bool, log *DryRunLog,
+) *DryRunEmail {
+	return &DryRunEmail{conf: conf, tmpl: tmpl, integration: integration, grafana: grafana, log: log}
+}
+
+func (n *DryRunEmail) Notify(ctx context.Context, alerts ...*am_types.Alert) (bool, error) {
+	_, log := logger.FromContext(ctx)
+	data := notify.GetTemplateData(ctx, n.tmpl, alerts, &GoKitAdapter{
+		Ctx: ctx, Logger: log, LogLevel: slog.LevelWarn, Message: "DryRunEmail",
+	})
+	now, has := notify.Now(ctx)
+	if has {
+		statusAt(data, alerts, now)
+	} else {
+		now = time.Now()
+	}
+	conf, subject := n.conf, am_config.DefaultEmailSubject
+	var err error
+	if n.grafana {
+		if conf, err = extendedEmailConfig(n.conf, n.tmpl, data); err != nil {
+			return false, err
+		}
+		subject = extendedTemplate(am_config.DefaultEmailSubject, "")
+	}
+	tmpl := notify.TmplText(n.tmpl, data, &err)
+	tmplHTML := notify.TmplHTML(n.tmpl, data, &err)
+
+	if header, has := conf.Headers["Subject"]; has {
+		subject = header
+	}
+	to := conf.To
+	if header, has := conf.Headers["To"]; has {
+		to = header
+	}
+	receiver, _ := notify.ReceiverName(ctx) //nolint:errcheck // empty, if not set
+	groupKey, _ := notify.GroupKey(ctx)     //nolint:errcheck // empty, if not set
+	notification := api.DryRunNotification{
+		Time:        now,
+		Receiver:    receiver,
+		Integration: n.integration,
+		GroupKey:    groupKey,
+		GroupLabels: api.LabelSet(data.GroupLabels),
+		Status:      data.Status,
+		Alerts:      make([]api.LabelSet, 0, len(alerts)),
+		To:          tmpl(to),
+		From:        tmpl(conf.From),
+		Subject:     tmpl(subject),
+		Text:        tmpl(conf.Text),
+		Html:        tmplHTML(conf.HTML),
+	}
+	if cc, has := conf.Headers["Cc"]; has {
+		cc = tmpl(cc)
+		notification.Cc = &cc
+	}
+	if err != nil {
+		return false, err
+	}
+	for _, alert := range data.Alerts {
+		notification.Alerts = append(notification.Alerts, api.LabelSet(alert.Labels))
+	}
+
+	notification = n.log.Add(notification)
+	log.Info("DRY_RUN_NOTIFICATION", "id", notification.Id, "receiver", notification.Receiver,
+		"integration", notification.Integration, "groupKey", notification.GroupKey, "status", notification.Status,
+		"alerts", len(notification.Alerts), "to", notification.To, "subject", notification.Subject)
+	log.Debug("DRY_RUN_NOTIFICATION_BODY", "id", notification.Id, "text", notification.Text, "html", notification.Html)
+
+	return false, nil
+}
+
+// statusAt sets the statuses of the template data at the time of the notification, instead of the real time.
+// It matters for the simulation, where the virtual time is in the past.
+func statusAt(data *template.Data, alerts []*am_types.Alert, now time.Time) {
+	data.Status = string(prom_model.AlertResolved)
+	for a, alert := range alerts {
+		if alert.ResolvedAt(now) {
+			data.Alerts[a].Status = string(prom_model.AlertResolved)
+		} else {
+			data.Alerts[a].Status = string(prom_model.AlertFiring)
+			data.Status = string(prom_model.AlertFiring)
+		}
+	}
+}
+
+func (s *ApiServer) GetDryRunNotifications(w http.ResponseWriter, r *http.Request, params api.GetDryRunNotificationsParams) {
+	_, log := logger.FromContext(r.Context())
+	receiver := ""
+	if params.Receiver != nil {
+		receiver = *params.Receiver
+	}
+
+	if err := api.GetDryRunNotifications200JSONResponse(s.service.notify.dryRunLog.List(receiver)).
+		VisitGetDryRunNotificationsResponse(w); err != nil {
+		log.Error("Unable to render response", logger.KeyError, logger.Wrap(ErrRenderResponse, err))
+	}
+}
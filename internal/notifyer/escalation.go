@@ -0,0 +1,196 @@
+package alertmanager
+
+import (
+	"context"
+	"errors"
+	"maps"
+	"net/http"
+	"slices"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/prometheus/alertmanager/dispatch"
+	am_types "github.com/prometheus/alertmanager/types"
+
+	"github.com/pgillich/micro-server/pkg/logger"
+
+	"github.com/pgillich/mimir-multitenant_alertmanager/configs"
+	api "github.com/pgillich/mimir-multitenant_alertmanager/pkg/api/notifyer"
+)
+
+// Escalator tracks the notified firing alert groups of the routes with escalation chain.
+// The next receiver of the chain is notified, if the group is still firing at the delay of the step.
+// The escalation stops, if all alerts of the group are resolved or an alert of the group is silenced or acknowledged.
+type Escalator struct {
+	mu          sync.Mutex
+	chains      map[string][]configs.EscalationStepConfig
+	escalations map[string]*escalationState
+}
+
+type escalationState struct {
+	group        alertGroup
+	fingerprints map[string]bool
+	startedAt    time.Time
+	escalatedTo  []string
+}
+
+func NewEscalator(tree *dispatch.Route, notifyerConfig *configs.NotifyerConfig) (*Escalator, error) {
+	e := &Escalator{
+		chains:      map[string][]configs.EscalationStepConfig{},
+		escalations: map[string]*escalationState{},
+	}
+	routeIDs := routeIDs(tree)
+	for _, escalation := range notifyerConfig.Escalations {
+		if !slices.Contains(routeIDs, escalation.RouteID) {
+			return nil, logger.Wrap(ErrUnknownRoute, errors.New(escalation.RouteID))
+		}
+		e.chains[escalation.RouteID] = escalation.Steps
+	}
+
+	return e, nil
+}
+
+// Start tracks the firing alerts of the notified group, if its route has escalation chain.
+// The start time of a tracked group is not changed by the new alerts of the group.
+func (e *Escalator) Start(now time.Time, group *alertGroup) {
+	routeID := group.route.ID()
+	if len(e.chains[routeID]) == 0 {
+		return
+	}
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	key := routeID + "/" + group.groupKey
+	for _, alert := range group.alerts {
+		if alert.ResolvedAt(now) {
+			continue
+		}
+		state, has := e.escalations[key]
+		if !has {
+			state = &escalationState{group: *group, fingerprints: map[string]bool{}, startedAt: now, escalatedTo: []string{}}
+			state.group.alerts = nil
+			e.escalations[key] = state
+		}
+		state.fingerprints[strconv.FormatUint(uint64(alert.Fingerprint()), 16)] = true
+	}
+}
+
+// Escalate stops the escalation of the resolved, silenced and acknowledged groups
+// and returns the groups to be notified by the next receiver of the chain.
+// The step is done by Escalated, so a failed step is returned again at the next evaluation.
+func (e *Escalator) Escalate(ctx context.Context, now time.Time, alerts map[string]api.GettableAlert) []alertGroup {
+	_, log := logger.FromContext(ctx)
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	groups := []alertGroup{}
+	for _, key := range slices.Sorted(maps.Keys(e.escalations)) {
+		state := e.escalations[key]
+		firing := []*am_types.Alert{}
+		silenced := false
+		acked := false
+		for _, fingerprint := range slices.Sorted(maps.Keys(state.fingerprints)) {
+			alert, has := alerts[fingerprint]
+			if !has {
+				continue
+			}
+			if len(alert.Status.SilencedBy) > 0 {
+				silenced = true
+			} else if alert.Annotations[configs.AckedByAnnotation] != "" {
+				acked = true
+			} else if AlertStateAt(alert, now) == string(api.Active) {
+				firing = append(firing, ApiAlertToPromAlert(alert))
+			}
+		}
+		if silenced || acked || len(firing) == 0 {
+			log.Info("Escalation stopped", "routeId", state.group.route.ID(), "groupKey", state.group.groupKey,
+				"silenced", silenced, "acked", acked, "escalatedTo", state.escalatedTo)
+			delete(e.escalations, key)
+
+			continue
+		}
+
+		chain := e.chains[state.group.route.ID()]
+		if step := len(state.escalatedTo); step < len(chain) && !now.Before(state.nextAt(chain)) {
+			log.Info("Escalation", "routeId", state.group.route.ID(), "groupKey", state.group.groupKey,
+				"step", step+1, "receiver", chain[step].Receiver)
+			group := state.group
+			group.receiver = chain[step].Receiver
+			group.alerts = firing
+			groups = append(groups, group)
+		}
+	}
+
+	return groups
+}
+
+// Escalated records the notified step of the escalated group
+func (e *Escalator) Escalated(group *alertGroup) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	if state, has := e.escalations[group.route.ID()+"/"+group.groupKey]; has {
+		state.escalatedTo = append(state.escalatedTo, group.receiver)
+	}
+}
+
+// List returns the escalation states, ordered by route ID and group key
+func (e *Escalator) List() []api.Escalation {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	escalations := make([]api.Escalation, 0, len(e.escalations))
+	for _, key := range slices.Sorted(maps.Keys(e.escalations)) {
+		state := e.escalations[key]
+		escalation := api.Escalation{
+			RouteId:      state.group.route.ID(),
+			Receiver:     state.group.receiver,
+			GroupKey:     state.group.groupKey,
+			GroupLabels:  api.LabelSet{},
+			Fingerprints: slices.Sorted(maps.Keys(state.fingerprints)),
+			StartedAt:    state.startedAt,
+			EscalatedTo:  slices.Clone(state.escalatedTo),
+		}
+		for name, value := range state.group.groupLabels {
+			escalation.GroupLabels[string(name)] = string(value)
+		}
+		chain := e.chains[escalation.RouteId]
+		if step := len(state.escalatedTo); step < len(chain) {
+			nextAt := state.nextAt(chain)
+			escalation.NextReceiver = &chain[step].Receiver
+			escalation.NextAt = &nextAt
+		}
+		escalations = append(escalations, escalation)
+	}
+
+	return escalations
+}
+
+// nextAt returns the time of the next step, the chain must not be finished
+func (s *escalationState) nextAt(chain []configs.EscalationStepConfig) time.Time {
+	return s.startedAt.Add(time.Duration(chain[len(s.escalatedTo)].DelaySec) * time.Second)
+}
+
+// escalate notifies the next receivers of the escalated groups, a failed step is retried at the next evaluation
+func (n *Notify) escalate(ctx context.Context, now time.Time, alerts map[string]api.GettableAlert) error {
+	var errs []error
+	for _, group := range n.escalations.Escalate(ctx, now, alerts) {
+		if err := n.notifyGroup(ctx, &group); err != nil {
+			errs = append(errs, err)
+
+			continue
+		}
+		n.escalations.Escalated(&group)
+	}
+
+	return errors.Join(errs...)
+}
+
+func (s *ApiServer) GetEscalations(w http.ResponseWriter, r *http.Request) {
+	_, log := logger.FromContext(r.Context())
+
+	if err := api.GetEscalations200JSONResponse(s.service.notify.escalations.List()).VisitGetEscalationsResponse(w); err != nil {
+		log.Error("Unable to render response", logger.KeyError, logger.Wrap(ErrRenderResponse, err))
+	}
+}
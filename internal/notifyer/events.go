@@ -0,0 +1,130 @@
+package alertmanager
+
+import (
+	"sync"
+	"time"
+
+	"github.com/prometheus/alertmanager/pkg/labels"
+	prom_model "github.com/prometheus/common/model"
+
+	api "github.com/pgillich/mimir-multitenant_alertmanager/pkg/api/notifyer"
+)
+
+const (
+	DefaultEventBufferSize = 1000
+	AlertStateResolved     = "resolved"
+
+	eventSubscriberBufferSize = 100
+)
+
+// EventBroker distributes the alert state changes to the subscribers.
+// The last events are kept in a ring buffer, so a subscriber can resume the stream by the last seen event ID.
+type EventBroker struct {
+	mu          sync.Mutex
+	lastID      uint64
+	ring        []api.AlertEvent
+	next        int
+	subscribers map[chan api.AlertEvent]struct{}
+}
+
+func NewEventBroker(size int) *EventBroker {
+	if size <= 0 {
+		size = DefaultEventBufferSize
+	}
+
+	return &EventBroker{
+		ring:        make([]api.AlertEvent, 0, size),
+		subscribers: map[chan api.AlertEvent]struct{}{},
+	}
+}
+
+// Publish sets the ID of the events, stores them in the ring buffer and sends them to the subscribers.
+// The channel of a subscriber, which is too slow to receive, is closed, so the client can reconnect
+// and resume the stream from the ring buffer. The number of the closed subscribers is returned.
+func (b *EventBroker) Publish(events ...api.AlertEvent) int {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	dropped := 0
+
+	for _, event := range events {
+		b.lastID++
+		event.Id = b.lastID
+		if len(b.ring) < cap(b.ring) {
+			b.ring = append(b.ring, event)
+		} else {
+			b.ring[b.next] = event
+			b.next = (b.next + 1) % cap(b.ring)
+		}
+
+		for subscriber := range b.subscribers {
+			select {
+			case subscriber <- event:
+			default:
+				delete(b.subscribers, subscriber)
+				close(subscriber)
+				dropped++
+			}
+		}
+	}
+
+	return dropped
+}
+
+// Subscribe returns the buffered events after lastEventID and the channel of the new events.
+// The returned function must be called to unsubscribe.
+func (b *EventBroker) Subscribe(lastEventID uint64) ([]api.AlertEvent, <-chan api.AlertEvent, func()) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	backlog := []api.AlertEvent{}
+	for i := range b.ring {
+		event := b.ring[(b.next+i)%len(b.ring)]
+		if event.Id > lastEventID {
+			backlog = append(backlog, event)
+		}
+	}
+
+	subscriber := make(chan api.AlertEvent, eventSubscriberBufferSize)
+	b.subscribers[subscriber] = struct{}{}
+
+	return backlog, subscriber, func() {
+		b.mu.Lock()
+		defer b.mu.Unlock()
+		delete(b.subscribers, subscriber)
+	}
+}
+
+// AlertState returns the state of the alert, resolved alerts have AlertStateResolved
+func AlertState(alert api.GettableAlert) string {
+	return AlertStateAt(alert, time.Now())
+}
+
+// AlertStateAt returns the state of the alert at the given time, see AlertState
+func AlertStateAt(alert api.GettableAlert, now time.Time) string {
+	if !alert.EndsAt.IsZero() && !alert.EndsAt.After(now) {
+		return AlertStateResolved
+	}
+
+	return string(alert.Status.State)
+}
+
+func newAlertEvent(now time.Time, tenantLabel string, oldState string, newState string, alert api.GettableAlert) api.AlertEvent {
+	return api.AlertEvent{
+		Time:        now,
+		Tenant:      alert.Labels[tenantLabel],
+		Fingerprint: alert.Fingerprint,
+		OldState:    oldState,
+		NewState:    newState,
+		Alert:       alert,
+	}
+}
+
+// MatchAlertEvent checks the labels of the alert of the event
+func MatchAlertEvent(matchers labels.Matchers, event api.AlertEvent) bool {
+	labelSet := prom_model.LabelSet{}
+	for k, v := range event.Alert.Labels {
+		labelSet[prom_model.LabelName(k)] = prom_model.LabelValue(v)
+	}
+
+	return matchers.Matches(labelSet)
+}
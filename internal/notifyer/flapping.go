@@ -0,0 +1,171 @@
+package alertmanager
+
+import (
+	"errors"
+	"maps"
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/prometheus/alertmanager/dispatch"
+	prom_model "github.com/prometheus/common/model"
+
+	"github.com/pgillich/micro-server/pkg/logger"
+
+	"github.com/pgillich/mimir-multitenant_alertmanager/configs"
+	api "github.com/pgillich/mimir-multitenant_alertmanager/pkg/api/notifyer"
+)
+
+// FlappingAnnotation marks the flapping alerts in the notifyer API and in the flapping notification
+const FlappingAnnotation = "flapping"
+
+var ErrUnknownRoute = errors.New("unknown route")
+
+// FlapDetector counts the state changes of the alerts in a sliding window, the appearance of an alert is a state change too.
+// The thresholds are looked up by the first matching route and its parents.
+type FlapDetector struct {
+	mu       sync.Mutex
+	tree     *dispatch.Route
+	defaults configs.FlapDetectionConfig
+	routes   map[string]configs.FlapDetectionConfig
+	alerts   map[string]*flapState
+}
+
+type flapState struct {
+	config   configs.FlapDetectionConfig
+	changes  []time.Time
+	flapping bool
+	alert    api.GettableAlert
+}
+
+func NewFlapDetector(tree *dispatch.Route, notifyerConfig *configs.NotifyerConfig) (*FlapDetector, error) {
+	d := &FlapDetector{
+		tree:     tree,
+		defaults: notifyerConfig.FlapDetection,
+		routes:   map[string]configs.FlapDetectionConfig{},
+		alerts:   map[string]*flapState{},
+	}
+	routeIDs := routeIDs(tree)
+	for _, routeFlapDetection := range notifyerConfig.RouteFlapDetection {
+		if !slices.Contains(routeIDs, routeFlapDetection.RouteID) {
+			return nil, logger.Wrap(ErrUnknownRoute, errors.New(routeFlapDetection.RouteID))
+		}
+		d.routes[routeFlapDetection.RouteID] = configs.FlapDetectionConfig{
+			Threshold: routeFlapDetection.Threshold,
+			WindowSec: routeFlapDetection.WindowSec,
+		}
+	}
+
+	return d, nil
+}
+
+// Update counts the state changes of the events and returns the alerts, which started or stopped flapping.
+// The alerts are the current snapshot, the last seen alert is returned for a stopped alert, which is missing.
+func (d *FlapDetector) Update(now time.Time, events []api.AlertEvent, alerts map[string]api.GettableAlert,
+) (started []api.GettableAlert, stopped []api.GettableAlert) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	for _, event := range events {
+		state, known := d.alerts[event.Fingerprint]
+		if !known {
+			flapDetection := d.flapDetection(event.Alert.Labels)
+			if flapDetection.Threshold <= 0 {
+				continue
+			}
+			state = &flapState{config: flapDetection}
+			d.alerts[event.Fingerprint] = state
+		}
+		state.alert = event.Alert
+		state.changes = append(state.changes, event.Time)
+	}
+
+	for _, fingerprint := range slices.Sorted(maps.Keys(d.alerts)) {
+		state := d.alerts[fingerprint]
+		if alert, has := alerts[fingerprint]; has {
+			state.alert = alert
+		}
+		windowStart := now.Add(-time.Duration(state.config.WindowSec) * time.Second)
+		state.changes = slices.DeleteFunc(state.changes, func(change time.Time) bool {
+			return !change.After(windowStart)
+		})
+		switch {
+		case !state.flapping && len(state.changes) >= state.config.Threshold:
+			state.flapping = true
+			started = append(started, state.alert)
+		case state.flapping && len(state.changes) == 0:
+			state.flapping = false
+			stopped = append(stopped, state.alert)
+			delete(d.alerts, fingerprint)
+		case !state.flapping && len(state.changes) == 0:
+			delete(d.alerts, fingerprint)
+		}
+	}
+
+	return started, stopped
+}
+
+// Flapping checks the alert by fingerprint
+func (d *FlapDetector) Flapping(fingerprint string) bool {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	state, has := d.alerts[fingerprint]
+
+	return has && state.flapping
+}
+
+// flapDetection returns the flap detection config of the first matching route or its nearest parent
+func (d *FlapDetector) flapDetection(labels api.LabelSet) configs.FlapDetectionConfig {
+	labelSet := prom_model.LabelSet{}
+	for name, value := range labels {
+		labelSet[prom_model.LabelName(name)] = prom_model.LabelValue(value)
+	}
+	matches := d.tree.Match(labelSet)
+	if len(matches) == 0 {
+		return d.defaults
+	}
+	ancestors := routeAncestors(d.tree, matches[0], nil)
+	for r := len(ancestors) - 1; r >= 0; r-- {
+		if flapDetection, has := d.routes[ancestors[r].ID()]; has {
+			return flapDetection
+		}
+	}
+
+	return d.defaults
+}
+
+// routeAncestors returns the routes from the root to the target route, nil if not found
+func routeAncestors(route *dispatch.Route, target *dispatch.Route, ancestors []*dispatch.Route) []*dispatch.Route {
+	ancestors = append(slices.Clone(ancestors), route)
+	if route == target {
+		return ancestors
+	}
+	for _, child := range route.Routes {
+		if found := routeAncestors(child, target, ancestors); found != nil {
+			return found
+		}
+	}
+
+	return nil
+}
+
+// routeIDs returns the IDs of the routes of the tree
+func routeIDs(route *dispatch.Route) []string {
+	ids := []string{}
+	route.Walk(func(r *dispatch.Route) {
+		ids = append(ids, r.ID())
+	})
+
+	return ids
+}
+
+// markFlapping returns a copy of the alert with the flapping annotation
+func markFlapping(alert api.GettableAlert) api.GettableAlert {
+	alert.Annotations = maps.Clone(alert.Annotations)
+	if alert.Annotations == nil {
+		alert.Annotations = api.LabelSet{}
+	}
+	alert.Annotations[FlappingAnnotation] = "true"
+
+	return alert
+}
@@ -0,0 +1,284 @@
+package alertmanager
+
+import (
+	"context"
+	"encoding/json"
+	html_tmpl "html/template"
+	"maps"
+	"net/url"
+	"slices"
+	"strconv"
+	"strings"
+	text_tmpl "text/template"
+	"text/template/parse"
+
+	am_config "github.com/prometheus/alertmanager/config"
+	"github.com/prometheus/alertmanager/notify"
+	"github.com/prometheus/alertmanager/notify/email"
+	"github.com/prometheus/alertmanager/template"
+	am_types "github.com/prometheus/alertmanager/types"
+	prom_model "github.com/prometheus/common/model"
+
+	"github.com/pgillich/mimir-multitenant_alertmanager/configs"
+	"github.com/pgillich/mimir-multitenant_alertmanager/internal/actionlink"
+	"github.com/pgillich/mimir-multitenant_alertmanager/internal/buildinfo"
+)
+
+// Grafana annotations, see https://github.com/grafana/alerting/blob/main/models/labels_annotations.go
+const (
+	AnnotationValues       = "__values__"
+	AnnotationValueString  = "__value_string__"
+	AnnotationDashboardUID = "__dashboardUid__"
+	AnnotationPanelID      = "__panelId__"
+	AnnotationOrgID        = "__orgId__"
+
+	// GrafanaSilenceDuration is the duration of the silences, created by the .SilenceURL action links
+	GrafanaSilenceDuration = "2h"
+)
+
+// ExtendedAlert is the alert of the Grafana template data.
+// It has the fields of the Alertmanager template alert, extended by the Grafana fields.
+// See https://github.com/grafana/alerting/blob/main/templates/template_data.go
+type ExtendedAlert struct {
+	template.Alert
+	SilenceURL   string
+	DashboardURL string
+	PanelURL     string
+	OrgID        *int64
+	Values       map[string]float64
+	ValueString  string
+	// ImageURL and EmbeddedImage are empty, the alert images are not supported
+	ImageURL      string
+	EmbeddedImage string
+}
+
+type ExtendedAlerts []ExtendedAlert
+
+// Firing returns the subset of alerts that are firing.
+func (as ExtendedAlerts) Firing() []ExtendedAlert {
+	res := []ExtendedAlert{}
+	for _, a := range as {
+		if a.Status == string(prom_model.AlertFiring) {
+			res = append(res, a)
+		}
+	}
+
+	return res
+}
+
+// Resolved returns the subset of alerts that are resolved.
+func (as ExtendedAlerts) Resolved() []ExtendedAlert {
+	res := []ExtendedAlert{}
+	for _, a := range as {
+		if a.Status == string(prom_model.AlertResolved) {
+			res = append(res, a)
+		}
+	}
+
+	return res
+}
+
+// extendedTemplate wraps the message template, so it's executed on the Grafana template data, see registerGrafana.
+// The defined templates are moved out of the wrapper, because they must be on the top level.
+// The message template is returned as is, if it can't be parsed, so the error is reported at execution.
+func extendedTemplate(text string, title string) string {
+	if !strings.Contains(text, "{{") {
+		return text
+	}
+	tree := parse.New("")
+	tree.Mode = parse.SkipFuncCheck
+	treeSet := map[string]*parse.Tree{}
+	if _, err := tree.Parse(text, "", "", treeSet); err != nil {
+		return text
+	}
+
+	var wrapped strings.Builder
+	wrapped.WriteString("{{ $ = extendedData . " + strconv.Quote(title) + " }}{{ with $ }}")
+	if top, has := treeSet[""]; has {
+		wrapped.WriteString(top.Root.String())
+	}
+	wrapped.WriteString("{{ end }}")
+	for _, name := range slices.Sorted(maps.Keys(treeSet)) {
+		if name != "" {
+			wrapped.WriteString("{{ define " + strconv.Quote(name) + " }}" + treeSet[name].Root.String() + "{{ end }}")
+		}
+	}
+
+	return wrapped.String()
+}
+
+// extendedEmailConfig returns a copy of the email config, which message templates are executed on the Grafana template data.
+// The .Title is the rendered subject.
+func extendedEmailConfig(emailConfig *am_config.EmailConfig, tmpl *template.Template, data *template.Data,
+) (*am_config.EmailConfig, error) {
+	subject := am_config.DefaultEmailSubject
+	if header, has := emailConfig.Headers["Subject"]; has {
+		subject = header
+	}
+	title, err := tmpl.ExecuteTextString(extendedTemplate(subject, ""), data)
+	if err != nil {
+		return nil, err
+	}
+
+	extended := *emailConfig
+	extended.Headers = make(map[string]string, len(emailConfig.Headers))
+	for name, value := range emailConfig.Headers {
+		extended.Headers[name] = extendedTemplate(value, title)
+	}
+	extended.To = extendedTemplate(emailConfig.To, title)
+	extended.From = extendedTemplate(emailConfig.From, title)
+	extended.Text = extendedTemplate(emailConfig.Text, title)
+	extended.HTML = extendedTemplate(emailConfig.HTML, title)
+
+	return &extended, nil
+}
+
+// ExtendedEmail is the email notifier, which executes the message templates on the Grafana template data
+type ExtendedEmail struct {
+	conf     *am_config.EmailConfig
+	tmpl     *template.Template
+	goKitLog *GoKitAdapter
+}
+
+func NewExtendedEmail(conf *am_config.EmailConfig, tmpl *template.Template, goKitLog *GoKitAdapter) *ExtendedEmail {
+	return &ExtendedEmail{conf: conf, tmpl: tmpl, goKitLog: goKitLog}
+}
+
+func (n *ExtendedEmail) Notify(ctx context.Context, alerts ...*am_types.Alert) (bool, error) {
+	data := notify.GetTemplateData(ctx, n.tmpl, alerts, n.goKitLog)
+	conf, err := extendedEmailConfig(n.conf, n.tmpl, data)
+	if err != nil {
+		return false, err
+	}
+
+	return email.New(conf, n.tmpl, n.goKitLog).Notify(ctx, alerts...)
+}
+
+// registerGrafana registers the extendedData template function, which returns the Grafana template data, see newExtendedData
+func registerGrafana(alertsConfig *configs.AlertsConfig, externalURL string, grafanaURL string) template.Option {
+	extendedData := newExtendedData(alertsConfig, externalURL, grafanaURL)
+
+	return func(text *text_tmpl.Template, html *html_tmpl.Template) {
+		text.Funcs(text_tmpl.FuncMap{"extendedData": extendedData})
+		html.Funcs(html_tmpl.FuncMap{"extendedData": extendedData})
+	}
+}
+
+// newExtendedData returns the function, which returns the Grafana template data of the Alertmanager template data,
+// filled from the Grafana annotations and the action links. The dashboard and panel links refer to grafanaURL.
+// See https://github.com/grafana/alerting/blob/main/receivers/email_sender.go
+func newExtendedData(alertsConfig *configs.AlertsConfig, externalURL string, grafanaURL string,
+) func(data *template.Data, title string) map[string]any {
+	actionURL := newActionURL(alertsConfig, externalURL)
+	alertPageURL := newUiURL(externalURL)
+
+	return func(data *template.Data, title string) map[string]any {
+		alerts := make(ExtendedAlerts, 0, len(data.Alerts))
+		for _, alert := range data.Alerts {
+			alerts = append(alerts, extendAlert(alert, grafanaURL,
+				actionURL(actionlink.ActionSilence, alert, GrafanaSilenceDuration)))
+		}
+		extended := map[string]any{
+			"Receiver":          data.Receiver,
+			"Status":            data.Status,
+			"Alerts":            alerts,
+			"GroupLabels":       removePrivateItems(data.GroupLabels),
+			"CommonLabels":      removePrivateItems(data.CommonLabels),
+			"CommonAnnotations": removePrivateItems(data.CommonAnnotations),
+			"ExternalURL":       data.ExternalURL,
+			"Title":             title,
+			"Message":           "",
+			"RuleUrl":           alertPageURL(),
+			"AlertPageUrl":      alertPageURL(),
+			"AppUrl":            data.ExternalURL,
+			"Subject":           map[string]any{},
+			"SentBy":            buildinfo.BuildInfo.AppName(),
+		}
+		extended["TemplateData"] = maps.Clone(extended)
+
+		return extended
+	}
+}
+
+// extendAlert fills the Grafana fields of the alert from the Grafana annotations, which are removed from the annotations
+func extendAlert(alert template.Alert, grafanaURL string, silenceURL string) ExtendedAlert {
+	extended := ExtendedAlert{Alert: alert, SilenceURL: silenceURL, ValueString: alert.Annotations[AnnotationValueString]}
+	extended.Labels = removePrivateItems(alert.Labels)
+	extended.Annotations = removePrivateItems(alert.Annotations)
+	if values := alert.Annotations[AnnotationValues]; values != "" {
+		if err := json.Unmarshal([]byte(values), &extended.Values); err != nil {
+			extended.Values = nil
+		}
+	}
+	query := url.Values{}
+	if orgID, err := strconv.ParseInt(alert.Annotations[AnnotationOrgID], 10, 64); err == nil {
+		extended.OrgID = &orgID
+		query.Set("orgId", alert.Annotations[AnnotationOrgID])
+	}
+	if dashboardUID := alert.Annotations[AnnotationDashboardUID]; dashboardUID != "" && grafanaURL != "" {
+		dashboardURL, err := url.JoinPath(grafanaURL, "d", dashboardUID)
+		if err != nil {
+			return extended
+		}
+		extended.DashboardURL = withQuery(dashboardURL, query)
+		if panelID := alert.Annotations[AnnotationPanelID]; panelID != "" {
+			query.Set("viewPanel", panelID)
+			extended.PanelURL = withQuery(dashboardURL, query)
+		}
+	}
+
+	return extended
+}
+
+func withQuery(link string, query url.Values) string {
+	if len(query) == 0 {
+		return link
+	}
+
+	return link + "?" + query.Encode()
+}
+
+// removePrivateItems returns the items without the "__" prefixed and suffixed names
+func removePrivateItems(kv template.KV) template.KV {
+	public := template.KV{}
+	for name, value := range kv {
+		if !strings.HasPrefix(name, "__") || !strings.HasSuffix(name, "__") {
+			public[name] = value
+		}
+	}
+
+	return public
+}
+
+// templateAlerts returns the Alertmanager template alerts of the alerts of the Alertmanager or Grafana template data
+func templateAlerts(alerts any) template.Alerts {
+	switch alerts := alerts.(type) {
+	case template.Alerts:
+		return alerts
+	case []template.Alert:
+		return alerts
+	case ExtendedAlerts:
+		return templateAlerts([]ExtendedAlert(alerts))
+	case []ExtendedAlert:
+		res := make(template.Alerts, 0, len(alerts))
+		for _, alert := range alerts {
+			res = append(res, alert.Alert)
+		}
+
+		return res
+	}
+
+	return nil
+}
+
+// templateAlert returns the Alertmanager template alert of an alert of the Alertmanager or Grafana template data
+func templateAlert(alert any) template.Alert {
+	switch alert := alert.(type) {
+	case template.Alert:
+		return alert
+	case ExtendedAlert:
+		return alert.Alert
+	}
+
+	return template.Alert{}
+}
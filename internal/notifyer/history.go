@@ -0,0 +1,257 @@
+package alertmanager
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"os"
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/prometheus/alertmanager/pkg/labels"
+	prom_model "github.com/prometheus/common/model"
+
+	"github.com/pgillich/micro-server/pkg/logger"
+
+	api "github.com/pgillich/mimir-multitenant_alertmanager/pkg/api/notifyer"
+)
+
+const (
+	DefaultHistoryRetention = 7 * 24 * time.Hour
+
+	historyFileMode = 0o600
+)
+
+var ErrHistoryStore = errors.New("history store error")
+
+// HistoryStore records the alert state transitions, the oldest is the first.
+// The transitions are appended to a JSON lines file, so the history survives a restart.
+// The expired transitions are dropped, the file is compacted, if it has more expired lines than retained ones.
+type HistoryStore struct {
+	mu          sync.Mutex
+	path        string
+	retention   time.Duration
+	transitions []api.AlertTransition
+	expired     int
+}
+
+// NewHistoryStore loads the history file and drops the expired transitions.
+// The history is kept in memory only, if the path is empty.
+func NewHistoryStore(path string, retention time.Duration, now time.Time) (*HistoryStore, error) {
+	if retention <= 0 {
+		retention = DefaultHistoryRetention
+	}
+	h := &HistoryStore{
+		path:        path,
+		retention:   retention,
+		transitions: []api.AlertTransition{},
+	}
+	if path == "" {
+		return h, nil
+	}
+
+	content, err := os.ReadFile(path) //nolint:gosec // file given by the config
+	if errors.Is(err, os.ErrNotExist) {
+		return h, nil
+	} else if err != nil {
+		return nil, logger.Wrap(ErrHistoryStore, err)
+	}
+	for _, line := range bytes.Split(content, []byte("\n")) {
+		if len(bytes.TrimSpace(line)) == 0 {
+			continue
+		}
+		transition := api.AlertTransition{}
+		if err := json.Unmarshal(line, &transition); err != nil {
+			return nil, logger.Wrap(ErrHistoryStore, err)
+		}
+		h.transitions = append(h.transitions, transition)
+	}
+
+	h.dropExpired(now)
+	if h.expired > 0 {
+		return h, h.compact()
+	}
+
+	return h, nil
+}
+
+// Record stores the transitions of the alert events
+func (h *HistoryStore) Record(now time.Time, events ...api.AlertEvent) error {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	lines := []byte{}
+	for _, event := range events {
+		transition := newAlertTransition(event)
+		h.transitions = append(h.transitions, transition)
+		if h.path != "" {
+			line, err := json.Marshal(transition)
+			if err != nil {
+				return logger.Wrap(ErrHistoryStore, err)
+			}
+			lines = append(append(lines, line...), '\n')
+		}
+	}
+	h.dropExpired(now)
+
+	if h.path == "" {
+		return nil
+	}
+	if h.expired > len(h.transitions) {
+		return h.compact()
+	}
+	if len(lines) == 0 {
+		return nil
+	}
+	file, err := os.OpenFile(h.path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, historyFileMode)
+	if err != nil {
+		return logger.Wrap(ErrHistoryStore, err)
+	}
+	if _, err := file.Write(lines); err != nil {
+		file.Close() //nolint:errcheck,gosec // the write error is returned
+
+		return logger.Wrap(ErrHistoryStore, err)
+	}
+
+	return logger.WrapIf(ErrHistoryStore, file.Close())
+}
+
+// dropExpired drops the transitions older than the retention, the lock must be held
+func (h *HistoryStore) dropExpired(now time.Time) {
+	limit := now.Add(-h.retention)
+	expired := 0
+	for expired < len(h.transitions) && h.transitions[expired].Time.Before(limit) {
+		expired++
+	}
+	if expired > 0 {
+		h.transitions = slices.Delete(h.transitions, 0, expired)
+		h.expired += expired
+	}
+}
+
+// compact rewrites the history file with the retained transitions, the lock must be held
+func (h *HistoryStore) compact() error {
+	content := []byte{}
+	for _, transition := range h.transitions {
+		line, err := json.Marshal(transition)
+		if err != nil {
+			return logger.Wrap(ErrHistoryStore, err)
+		}
+		content = append(append(content, line...), '\n')
+	}
+	tmpPath := h.path + ".tmp"
+	if err := os.WriteFile(tmpPath, content, historyFileMode); err != nil {
+		return logger.Wrap(ErrHistoryStore, err)
+	}
+	if err := os.Rename(tmpPath, h.path); err != nil {
+		return logger.Wrap(ErrHistoryStore, err)
+	}
+	h.expired = 0
+
+	return nil
+}
+
+// Query returns the transitions of the matching alerts in the time range and the firing intervals overlapping it.
+// A firing interval lasts from the transition to active until the next transition of the alert to another state.
+func (h *HistoryStore) Query(matchers labels.Matchers, start time.Time, end time.Time) api.AlertHistory {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	history := api.AlertHistory{Transitions: []api.AlertTransition{}, Intervals: []api.FiringInterval{}}
+	open := map[string]int{}
+	for _, transition := range h.transitions {
+		if transition.Time.After(end) {
+			break
+		}
+		if !matchTransition(matchers, transition) {
+			continue
+		}
+		if !transition.Time.Before(start) {
+			history.Transitions = append(history.Transitions, transition)
+		}
+
+		i, firing := open[transition.Fingerprint]
+		switch {
+		case transition.NewState == string(api.Active) && !firing:
+			open[transition.Fingerprint] = len(history.Intervals)
+			history.Intervals = append(history.Intervals, api.FiringInterval{
+				Fingerprint: transition.Fingerprint,
+				Tenant:      transition.Tenant,
+				Labels:      transition.Labels,
+				Start:       transition.Time,
+			})
+		case transition.NewState != string(api.Active) && firing:
+			history.Intervals[i].End = &transition.Time
+			delete(open, transition.Fingerprint)
+		}
+	}
+
+	history.Intervals = slices.DeleteFunc(history.Intervals, func(interval api.FiringInterval) bool {
+		return interval.End != nil && interval.End.Before(start)
+	})
+
+	return history
+}
+
+func newAlertTransition(event api.AlertEvent) api.AlertTransition {
+	return api.AlertTransition{
+		Time:        event.Time,
+		Tenant:      event.Tenant,
+		Fingerprint: event.Fingerprint,
+		Labels:      event.Alert.Labels,
+		OldState:    event.OldState,
+		NewState:    event.NewState,
+		StartsAt:    event.Alert.StartsAt,
+		EndsAt:      event.Alert.EndsAt,
+	}
+}
+
+func matchTransition(matchers labels.Matchers, transition api.AlertTransition) bool {
+	labelSet := prom_model.LabelSet{}
+	for k, v := range transition.Labels {
+		labelSet[prom_model.LabelName(k)] = prom_model.LabelValue(v)
+	}
+
+	return matchers.Matches(labelSet)
+}
+
+func (s *ApiServer) GetAlertHistory(w http.ResponseWriter, r *http.Request, params api.GetAlertHistoryParams) {
+	_, log := logger.FromContext(r.Context())
+	matchers := labels.Matchers{}
+	if params.Filter != nil {
+		for _, filter := range *params.Filter {
+			matcher, err := labels.ParseMatcher(filter)
+			if err != nil {
+				log.Warn("Unable to parse filter", logger.KeyError, err)
+				if err = api.GetAlertHistory400JSONResponse(logger.Wrap(ErrInvalidRequest, err).Error()).VisitGetAlertHistoryResponse(w); err != nil {
+					log.Error("Unable to render error response", logger.KeyError, logger.Wrap(ErrRenderResponse, err))
+				}
+				return
+			}
+			matchers = append(matchers, matcher)
+		}
+	}
+	start := time.Time{}
+	if params.Start != nil {
+		start = *params.Start
+	}
+	end := s.service.notify.now()
+	if params.End != nil {
+		end = *params.End
+	}
+	if end.Before(start) {
+		log.Warn("Invalid time range", "start", start, "end", end)
+		if err := api.GetAlertHistory400JSONResponse(logger.Wrap(ErrInvalidRequest, errors.New("end is before start")).Error()).
+			VisitGetAlertHistoryResponse(w); err != nil {
+			log.Error("Unable to render error response", logger.KeyError, logger.Wrap(ErrRenderResponse, err))
+		}
+		return
+	}
+
+	if err := api.GetAlertHistory200JSONResponse(s.service.notify.history.Query(matchers, start, end)).
+		VisitGetAlertHistoryResponse(w); err != nil {
+		log.Error("Unable to render response", logger.KeyError, logger.Wrap(ErrRenderResponse, err))
+	}
+}
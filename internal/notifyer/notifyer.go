@@ -7,17 +7,22 @@ import (
 	"fmt"
 	html_tmpl "html/template"
 	"log/slog"
+	"maps"
 	"net/http"
 	"net/url"
 	"os"
+	"slices"
 	"strings"
 	text_tmpl "text/template"
 	"time"
 
 	"github.com/Masterminds/sprig/v3"
+	am_config "github.com/prometheus/alertmanager/config"
+	"github.com/prometheus/alertmanager/dispatch"
 	"github.com/prometheus/alertmanager/notify"
 	"github.com/prometheus/alertmanager/notify/email"
 	"github.com/prometheus/alertmanager/template"
+	"github.com/prometheus/alertmanager/timeinterval"
 	am_types "github.com/prometheus/alertmanager/types"
 	prom_model "github.com/prometheus/common/model"
 	"go.opentelemetry.io/otel/trace"
@@ -28,6 +33,7 @@ import (
 	mw_inner "github.com/pgillich/micro-server/pkg/middleware/inner"
 
 	"github.com/pgillich/mimir-multitenant_alertmanager/configs"
+	"github.com/pgillich/mimir-multitenant_alertmanager/internal/actionlink"
 	"github.com/pgillich/mimir-multitenant_alertmanager/internal/buildinfo"
 	api "github.com/pgillich/mimir-multitenant_alertmanager/pkg/api/notifyer"
 )
@@ -39,38 +45,80 @@ type NotifyStat struct {
 
 var (
 	ErrUnableToPrepareNotifier = errors.New("unable to prepare notifier")
-	ErrAlertMismatchResolved   = errors.New("alert mismatch resolved")
 )
 
 func initNotifier(ctx context.Context, serverConfig *configs.ServerConfig, testConfig *configs.TestConfig, tr trace.Tracer) (*Notify, error) {
+	notify, err := newNotify(ctx, serverConfig.Alerts, serverConfig.Notifyer)
+	if err != nil {
+		return nil, err
+	}
+	notify.testConfig = testConfig
+	notify.tr = tr
+
+	notify.alertClient, err = NewAlertClient(ctx, serverConfig, testConfig, configs.ServiceNameNotifyer)
+	if err != nil {
+		return nil, logger.Wrap(ErrUnableToPrepareNotifier, err)
+	}
+
+	return notify, nil
+}
+
+// newNotify creates the notifier without the alert source, with the real clock
+func newNotify(ctx context.Context, alertsConfig *configs.AlertsConfig, notifyerConfig *configs.NotifyerConfig) (*Notify, error) {
 	_, log := logger.FromContext(ctx)
-	hostname, _ := os.Hostname() //nolint:errcheck // not important
-	var err error
+	notifyerConfig, err := WithTenantConfigs(alertsConfig, notifyerConfig)
+	if err != nil {
+		return nil, logger.Wrap(ErrUnableToPrepareNotifier, err)
+	}
 
 	notify := &Notify{
-		testConfig: testConfig,
-		config:     serverConfig.Notifyer,
-		tr:         tr,
+		config:       notifyerConfig,
+		alertsConfig: alertsConfig,
+		tenantLabel:  configs.DefaultTenantLabel,
+		events:       NewEventBroker(notifyerConfig.EventBufferSize),
+		dryRunLog:    NewDryRunLog(notifyerConfig.DryRunLogSize),
+		now:          time.Now,
+	}
+	if alertsConfig != nil && alertsConfig.TenantLabel != "" {
+		notify.tenantLabel = alertsConfig.TenantLabel
 	}
 	notify.lastAlerts.Store(&map[string]api.GettableAlert{})
+	notify.missing = map[string]int{}
 
-	alertmanagerUrl := notify.config.AlertmanagerUrl
-	if alertmanagerUrl == "" {
-		alertmanagerUrl, err = url.JoinPath("http://"+serverConfig.GetListenAddr(), configs.ServiceNameAlertmanager, "/api/v2")
-		if err != nil {
-			return nil, logger.Wrap(ErrUnableToPrepareNotifier, err)
-		}
+	notify.history, err = NewHistoryStore(notifyerConfig.HistoryPath,
+		time.Duration(notifyerConfig.HistoryRetentionHours)*time.Hour, notify.now())
+	if err != nil {
+		return nil, logger.Wrap(ErrUnableToPrepareNotifier, err)
 	}
-	httpClient := mw_client.NewHttpClient(hostname, configs.ServiceNameNotifyer, TargetServiceName,
-		buildinfo.BuildInfo, testConfig, log, slog.LevelInfo, slog.LevelInfo)
 
-	notify.alertClient, err = api.NewClientWithResponses(
-		alertmanagerUrl,
-		api.WithHTTPClient(httpClient),
-	)
+	notify.routeTree, err = NewRouteTree(notify.config.Route)
 	if err != nil {
 		return nil, logger.Wrap(ErrUnableToPrepareNotifier, err)
 	}
+	notify.flaps, err = NewFlapDetector(notify.routeTree, notify.config)
+	if err != nil {
+		return nil, logger.Wrap(ErrUnableToPrepareNotifier, err)
+	}
+	timeIntervals, err := NewTimeIntervals(notify.config.TimeIntervals)
+	if err != nil {
+		return nil, logger.Wrap(ErrUnableToPrepareNotifier, err)
+	}
+	notify.intervener = timeinterval.NewIntervener(timeIntervals)
+	notify.escalations, err = NewEscalator(notify.routeTree, notify.config)
+	if err != nil {
+		return nil, logger.Wrap(ErrUnableToPrepareNotifier, err)
+	}
+	notify.held = map[string]*heldGroup{}
+	notify.recipients = map[string]configs.RecipientsConfig{}
+	for _, recipients := range notify.config.Recipients {
+		notify.recipients[recipients.Receiver] = recipients
+	}
+	notify.digests, err = NewDigester(notify.config.Digests)
+	if err != nil {
+		return nil, logger.Wrap(ErrUnableToPrepareNotifier, err)
+	}
+	notify.storms = NewStormGuard(notify.config.StormProtection)
+	notify.changes = NewChangeDetector(notify.config.ChangeDetection)
 
 	goKitLog := &GoKitAdapter{
 		Ctx:      ctx,
@@ -79,37 +127,97 @@ func initNotifier(ctx context.Context, serverConfig *configs.ServerConfig, testC
 		Message:  "Notifyer",
 	}
 
-	notify.template, err = template.New(registerSprig)
-	if err != nil {
+	notify.goKitLog = goKitLog
+	if err = notify.loadTemplates(log, notify.now()); err != nil {
 		return nil, logger.Wrap(ErrUnableToPrepareNotifier, err)
 	}
-	notify.template.ExternalURL, err = url.ParseRequestURI(notify.config.ExternalURL)
-	if err != nil {
-		return nil, logger.Wrap(ErrUnableToPrepareNotifier, err)
+
+	return notify, nil
+}
+
+// newNotifiers creates the email notifiers of the receivers.
+// The receivers in dry-run mode get DryRunEmail notifiers, which record the notifications instead of sending them.
+// The Grafana receivers execute the message templates on the Grafana template data.
+func newNotifiers(log *slog.Logger, notifyerConfig *configs.NotifyerConfig, tmpl *template.Template, dryRunLog *DryRunLog,
+	goKitLog *GoKitAdapter,
+) map[string][]notify.Notifier {
+	notifiers := map[string][]notify.Notifier{}
+	for _, receiver := range notifyerConfig.Receivers {
+		dryRun := notifyerConfig.DryRun || slices.Contains(notifyerConfig.DryRunReceivers, receiver.Name)
+		grafana := slices.Contains(notifyerConfig.GrafanaReceivers, receiver.Name)
+		receiverNotifiers := make([]notify.Notifier, 0, len(receiver.EmailConfigs))
+		for e, emailConfig := range receiver.EmailConfigs {
+			if emailConfig.Headers == nil {
+				emailConfig.Headers = map[string]string{}
+			}
+			receiverNotifiers = append(receiverNotifiers, newEmailNotifier(emailConfig, e, dryRun, grafana, tmpl, dryRunLog, goKitLog))
+		}
+		notifiers[receiver.Name] = receiverNotifiers
+		log.Info("Receiver prepared", "receiver", receiver.Name, "integrations", len(receiverNotifiers), "dryRun", dryRun)
 	}
-	err = templateFromContent(notify.template, notify.config.Templates)
-	if err != nil {
-		return nil, logger.Wrap(ErrUnableToPrepareNotifier, err)
+
+	return notifiers
+}
+
+func newEmailNotifier(emailConfig *am_config.EmailConfig, index int, dryRun bool, grafana bool, tmpl *template.Template,
+	dryRunLog *DryRunLog, goKitLog *GoKitAdapter,
+) notify.Notifier {
+	if dryRun {
+		return NewDryRunEmail(emailConfig, tmpl, fmt.Sprintf("email[%d]", index), grafana, dryRunLog)
+	}
+	if grafana {
+		return NewExtendedEmail(emailConfig, tmpl, goKitLog)
 	}
 
-	for _, receiver := range notify.config.Receivers {
-		if receiver.Name == notify.config.Route.Receiver {
-			if receiver.EmailConfigs == nil {
-				return nil, logger.Wrap(ErrUnableToPrepareNotifier, errors.New("only email receivers are supported"))
-			}
-			if len(receiver.EmailConfigs) != 1 {
-				return nil, logger.Wrap(ErrUnableToPrepareNotifier, errors.New("only one email receiver is supported"))
-			}
-			notify.notifier = email.New(receiver.EmailConfigs[0], notify.template, goKitLog)
-			notify.resolvedNotifier = email.New(receiver.EmailConfigs[0], notify.template, goKitLog)
+	return email.New(emailConfig, tmpl, goKitLog)
+}
 
-			notify.receiver = &receiver
+// NewAlertClient creates the client of the multi-tenant alerts API.
+// The multitenant-alertmanager service on the listen address is used, if Notifyer.AlertmanagerUrl is not set.
+func NewAlertClient(ctx context.Context, serverConfig *configs.ServerConfig, testConfig *configs.TestConfig, serviceName string,
+) (*api.ClientWithResponses, error) {
+	_, log := logger.FromContext(ctx)
+	hostname, _ := os.Hostname() //nolint:errcheck // not important
+	var err error
 
-			return notify, nil
+	alertmanagerUrl := serverConfig.Notifyer.AlertmanagerUrl
+	if alertmanagerUrl == "" {
+		alertmanagerUrl, err = url.JoinPath("http://"+serverConfig.GetListenAddr(), configs.ServiceNameAlertmanager, "/api/v2")
+		if err != nil {
+			return nil, err
 		}
 	}
+	httpClient := mw_client.NewHttpClient(hostname, serviceName, TargetServiceName,
+		buildinfo.BuildInfo, testConfig, log, slog.LevelInfo, slog.LevelInfo)
 
-	return nil, logger.Wrap(ErrUnableToPrepareNotifier, errors.New("receivers not found"))
+	return api.NewClientWithResponses(
+		alertmanagerUrl,
+		api.WithHTTPClient(httpClient),
+	)
+}
+
+// newTemplate creates the notification template with the extension functions, without template sources
+func newTemplate(alertsConfig *configs.AlertsConfig, externalURL string, grafanaURL string, options ...template.Option,
+) (*template.Template, error) {
+	tmpl, err := template.New(append([]template.Option{
+		registerSprig, registerTenantMeta(alertsConfig), registerUiLinks(externalURL), registerActionLinks(alertsConfig, externalURL),
+		registerDigest(alertsConfig), registerStorm(alertsConfig), registerGrafana(alertsConfig, externalURL, grafanaURL), registerChanges,
+	}, options...)...)
+	if err != nil {
+		return nil, err
+	}
+	if err = tmpl.Parse(strings.NewReader(digestTemplates)); err != nil {
+		return nil, err
+	}
+	if err = tmpl.Parse(strings.NewReader(stormTemplates)); err != nil {
+		return nil, err
+	}
+	tmpl.ExternalURL, err = url.ParseRequestURI(externalURL)
+	if err != nil {
+		return nil, err
+	}
+
+	return tmpl, nil
 }
 
 func templateFromContent(t *template.Template, tmpls []string) error {
@@ -192,82 +300,286 @@ func observeEvalNotif(tr trace.Tracer, jobNum int, evalNotif func(context.Contex
 }
 
 func (n *Notify) evalNotif(ctx context.Context) (NotifyStat, error) {
+	alerts, failedTenants, err := GetPartialAlerts(ctx, n.alertClient)
+	if err != nil {
+		return NotifyStat{}, err
+	}
+
+	return n.processAlerts(ctx, *alerts, failedTenants, n.now())
+}
+
+// processAlerts compares the alerts to the alerts of the previous evaluation and notifies the changes.
+// The alerts of the failed tenants are kept, see disappeared.
+// The time of the evaluation is now, so the evaluation can be replayed with a virtual clock.
+func (n *Notify) processAlerts(ctx context.Context, alerts api.GettableAlerts, failedTenants []string, now time.Time,
+) (NotifyStat, error) {
 	_, log := logger.FromContext(ctx)
+	n.reloadTemplates(ctx, now)
 	notifyStat := NotifyStat{}
-	reportAlerts := []*am_types.Alert{}
-	resolvedAlerts := []*am_types.Alert{}
+	reportCandidates := []api.GettableAlert{}
+	resolvedCandidates := []api.GettableAlert{}
 	newAlerts := map[string]api.GettableAlert{}
 	lastAlerts := *n.lastAlerts.Load()
-
-	alerts, err := n.getAlerts(ctx)
-	if err != nil {
-		return notifyStat, err
-	}
-
-	for _, alert := range *alerts {
-		if _, has := lastAlerts[alert.Fingerprint]; has { // existing
-			promAlert := ApiAlertToPromAlert(alert)
-			if alert.Status.State != lastAlerts[alert.Fingerprint].Status.State { // updated
-				if promAlert.Resolved() { // resolved (?)
-					resolvedAlerts = append(resolvedAlerts, promAlert)
+	events := []api.AlertEvent{}
+	ctx = notify.WithNow(ctx, now)
+	relabeled := n.changes.Relabeled(lastAlerts, alerts)
+	replaced := map[string]bool{}
+
+	for _, alert := range alerts {
+		if lastAlert, has := lastAlerts[alert.Fingerprint]; has { // existing
+			if oldState, newState := AlertStateAt(lastAlert, now), AlertStateAt(alert, now); oldState != newState {
+				events = append(events, newAlertEvent(now, n.tenantLabel, oldState, newState, alert))
+			}
+			if alert.Status.State != lastAlert.Status.State { // updated
+				if ApiAlertToPromAlert(alert).ResolvedAt(now) { // resolved (?)
+					resolvedCandidates = append(resolvedCandidates, alert)
 				} else { // firing (or pending?)
-					reportAlerts = append(reportAlerts, promAlert)
+					reportCandidates = append(reportCandidates, alert)
 				}
+			} else if changed, has := n.changes.Changed(lastAlert, alert); has && alertFiring(alert, now) { // changed
+				reportCandidates = append(reportCandidates, changed)
 			}
 		} else { // new
-			promAlert := ApiAlertToPromAlert(alert)
-			if promAlert.Resolved() { // resolved (?)
-				resolvedAlerts = append(resolvedAlerts, promAlert)
+			events = append(events, newAlertEvent(now, n.tenantLabel, "", AlertStateAt(alert, now), alert))
+			oldAlert, has := relabeled[n.changes.Key(alert)]
+			if has && !replaced[oldAlert.Fingerprint] && alertFiring(alert, now) && alertFiring(oldAlert, now) { // relabeled
+				replaced[oldAlert.Fingerprint] = true
+				changed, _ := n.changes.Changed(oldAlert, alert)
+				reportCandidates = append(reportCandidates, changed)
+			} else if ApiAlertToPromAlert(alert).ResolvedAt(now) { // resolved (?)
+				resolvedCandidates = append(resolvedCandidates, alert)
 			} else { // firing (or pending?)
-				reportAlerts = append(reportAlerts, promAlert)
+				reportCandidates = append(reportCandidates, alert)
 			}
 		}
 		newAlerts[alert.Fingerprint] = alert
+		delete(n.missing, alert.Fingerprint)
 	}
 
 	for _, alert := range lastAlerts {
 		if _, has := newAlerts[alert.Fingerprint]; !has { // removed, should be resolved
-			promAlert := ApiAlertToPromAlert(alert)
-			if promAlert.Resolved() { // resolved
-				resolvedAlerts = append(resolvedAlerts, promAlert)
-			} else {
-				log.Warn("ERR_ALERT_MISMATCH", logger.KeyError, ErrAlertMismatchResolved, "alert", promAlert.String(), "endsAt", promAlert.EndsAt)
-				// notify as resolved: patch endsAt
-				promAlert.EndsAt = time.Now()
+			oldState := AlertStateAt(alert, now)
+			if replaced[alert.Fingerprint] { // relabeled, the new alert is notified
+				events = append(events, newAlertEvent(now, n.tenantLabel, oldState, AlertStateResolved, alert))
+				delete(n.missing, alert.Fingerprint)
+
+				continue
+			}
+			if !ApiAlertToPromAlert(alert).ResolvedAt(now) {
+				var gone bool
+				if alert, gone = n.disappeared(ctx, now, alert, failedTenants); !gone {
+					newAlerts[alert.Fingerprint] = alert
+
+					continue
+				}
+			}
+			if oldState != AlertStateResolved {
+				events = append(events, newAlertEvent(now, n.tenantLabel, oldState, AlertStateResolved, alert))
 			}
+			resolvedCandidates = append(resolvedCandidates, alert)
 		}
 	}
+
+	reportAlerts, resolvedAlerts := n.flapFilter(ctx, now, events, newAlerts, reportCandidates, resolvedCandidates)
 	notifyStat = NotifyStat{
 		Firing:   len(reportAlerts),
 		Resolved: len(resolvedAlerts),
 	}
 
-	ctx = notify.WithReceiverName(ctx, "ReceiverName")
-	/*
-		groupLabels := prom_model.LabelSet{}
-		for _, groupLabel := range s.notifyerConfig.Route.GroupByStr {
-			groupLabels.
+	// a failed group doesn't block the others, it's retried at the next evaluation,
+	// the snapshot is stored anyway, so the delivered groups are not notified again
+	err := errors.Join(
+		n.releaseHeld(ctx, now, newAlerts),
+		n.dispatch(ctx, reportAlerts),
+		n.dispatch(ctx, resolvedAlerts),
+		n.escalate(ctx, now, newAlerts),
+		n.digest(ctx, now, newAlerts),
+	)
+
+	n.lastAlerts.Store(&newAlerts)
+	if dropped := n.events.Publish(events...); dropped > 0 {
+		log.Warn("Slow alert event subscribers are closed", "subscribers", dropped)
+	}
+	if err := n.history.Record(now, events...); err != nil {
+		log.Error("Unable to record alert history", logger.KeyError, err)
+	}
+
+	return notifyStat, err
+}
+
+// flapFilter drops the notifications of the flapping alerts.
+// An alert gets one notification with the flapping annotation, when it starts flapping,
+// and a notification of its current state, when it's stable again.
+// The flapping alerts of the snapshot get the flapping annotation.
+func (n *Notify) flapFilter(ctx context.Context, now time.Time, events []api.AlertEvent, alerts map[string]api.GettableAlert,
+	reportCandidates []api.GettableAlert, resolvedCandidates []api.GettableAlert,
+) (reportAlerts []*am_types.Alert, resolvedAlerts []*am_types.Alert) {
+	_, log := logger.FromContext(ctx)
+	started, stopped := n.flaps.Update(now, events, alerts)
+	notifyAlert := func(alert api.GettableAlert) {
+		promAlert := ApiAlertToPromAlert(alert)
+		if promAlert.ResolvedAt(now) {
+			resolvedAlerts = append(resolvedAlerts, promAlert)
+		} else {
+			reportAlerts = append(reportAlerts, promAlert)
+		}
+	}
+
+	for _, alert := range reportCandidates {
+		if !n.flaps.Flapping(alert.Fingerprint) {
+			reportAlerts = append(reportAlerts, ApiAlertToPromAlert(alert))
 		}
-		ctx = notify.WithGroupLabels(ctx, prom_model.LabelSet{DefaultGroupLabel: DefaultGroupLabelValue})
-	*/
-	if len(reportAlerts) > 0 {
-		hasErr, err := n.notifier.Notify(ctx, reportAlerts...)
-		if err != nil {
-			return notifyStat, err
+	}
+	for _, alert := range resolvedCandidates {
+		if !n.flaps.Flapping(alert.Fingerprint) {
+			resolvedAlerts = append(resolvedAlerts, ApiAlertToPromAlert(alert))
 		}
-		_ = hasErr
 	}
-	if len(resolvedAlerts) > 0 {
-		hasErr, err := n.resolvedNotifier.Notify(ctx, resolvedAlerts...)
-		if err != nil {
-			return notifyStat, err
+	for _, alert := range started {
+		log.Info("ALERT_FLAPPING", "fingerprint", alert.Fingerprint, "alert", alert.Labels)
+		notifyAlert(markFlapping(alert))
+	}
+	for _, alert := range stopped {
+		log.Info("ALERT_STABLE", "fingerprint", alert.Fingerprint, "alert", alert.Labels)
+		alert.Annotations = maps.Clone(alert.Annotations)
+		delete(alert.Annotations, FlappingAnnotation)
+		if _, has := alerts[alert.Fingerprint]; !has && !ApiAlertToPromAlert(alert).ResolvedAt(now) {
+			// notify as resolved: patch endsAt
+			alert.EndsAt = now
+		}
+		notifyAlert(alert)
+	}
+	for fingerprint, alert := range alerts {
+		if n.flaps.Flapping(fingerprint) {
+			alerts[fingerprint] = markFlapping(alert)
 		}
-		_ = hasErr
 	}
 
-	n.lastAlerts.Store(&newAlerts)
+	return reportAlerts, resolvedAlerts
+}
+
+// alertGroup is the alerts of a notification, grouped by the matched route and the group labels
+type alertGroup struct {
+	route       *dispatch.Route
+	receiver    string
+	groupKey    string
+	groupLabels prom_model.LabelSet
+	alerts      []*am_types.Alert
+}
+
+// dispatch routes the alerts by the routing tree and notifies the receivers by alert groups.
+// The alerts of the muted routes are held until the end of the muted time interval.
+// The notified firing groups of the routes with escalation chain are tracked by the escalator.
+// All groups are notified, the errors are joined. The failed groups are held and retried at the next evaluation.
+func (n *Notify) dispatch(ctx context.Context, alerts []*am_types.Alert) error {
+	_, log := logger.FromContext(ctx)
+	now, has := notify.Now(ctx)
+	if !has {
+		now = n.now()
+	}
+	groups := map[string]*alertGroup{}
+	for _, alert := range alerts {
+		for _, route := range n.routeTree.Match(alert.Labels) {
+			groupLabels := routeGroupLabels(route.RouteOpts, alert.Labels)
+			groupKey := fmt.Sprintf("%s:%s", route.Key(), groupLabels)
+			key := route.RouteOpts.Receiver + "/" + groupKey
+			if _, has := groups[key]; !has {
+				groups[key] = &alertGroup{route: route, receiver: route.RouteOpts.Receiver, groupKey: groupKey, groupLabels: groupLabels}
+			}
+			groups[key].alerts = append(groups[key].alerts, alert)
+		}
+	}
+
+	var errs []error
+	notifyGroups := []*alertGroup{}
+	for _, key := range slices.Sorted(maps.Keys(groups)) {
+		group := groups[key]
+		if n.digests.Has(group.receiver) {
+			log.Debug("Notification collected for digest", "receiver", group.receiver, "groupKey", group.groupKey,
+				"alerts", len(group.alerts))
+
+			continue
+		}
+		if !n.changes.Admit(now, group) {
+			suppressed(ctx, log, group.receiver, SuppressReasonChangeRateLimit, 1)
+
+			continue
+		}
+		if muted, err := n.routeMuted(group.route, now); err != nil {
+			log.Error("Unable to check time intervals", "receiver", group.receiver, "groupKey", group.groupKey, logger.KeyError, err)
+			errs = append(errs, err)
+		} else if muted {
+			log.Debug("Notification muted", "receiver", group.receiver, "groupKey", group.groupKey, "alerts", len(group.alerts))
+			n.hold(group, now)
+
+			continue
+		}
+		notifyGroups = append(notifyGroups, group)
+	}
+	failed, notifyErrs := n.notifyGroups(ctx, now, notifyGroups)
+	for _, group := range failed {
+		n.hold(group, now)
+	}
 
-	return notifyStat, nil
+	return errors.Join(append(errs, notifyErrs...)...)
+}
+
+// notifyGroup notifies the alerts of the group by all integrations of the receiver.
+// A firing group is not notified, if all of its alerts are acknowledged, but the resolved notification is sent.
+func (n *Notify) notifyGroup(ctx context.Context, group *alertGroup) error {
+	_, log := logger.FromContext(ctx)
+	now, has := notify.Now(ctx)
+	if !has {
+		now = n.now()
+	}
+	if groupAcked(group.alerts, now) {
+		log.Info("Notification of acknowledged group skipped", "receiver", group.receiver, "groupKey", group.groupKey,
+			"alerts", len(group.alerts))
+
+		return nil
+	}
+	if recipients, has := n.recipients[group.receiver]; has {
+		return n.notifyRecipients(ctx, group, recipients)
+	}
+
+	return n.notifyIntegrations(ctx, group, n.templates.Load().notifiers[group.receiver])
+}
+
+// notifyIntegrations notifies the alerts of the group by the integrations
+func (n *Notify) notifyIntegrations(ctx context.Context, group *alertGroup, notifiers []notify.Notifier) error {
+	_, log := logger.FromContext(ctx)
+	groupCtx := groupContext(ctx, group)
+	if len(notifiers) == 0 {
+		log.Debug("Receiver without integrations", "receiver", group.receiver, "groupKey", group.groupKey, "alerts", len(group.alerts))
+	}
+	var errs []error
+	for _, notifier := range notifiers {
+		if _, err := notifier.Notify(groupCtx, group.alerts...); err != nil {
+			log.Error("Unable to notify", "receiver", group.receiver, "groupKey", group.groupKey, logger.KeyError, err)
+			errs = append(errs, err)
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+// groupContext returns the context with the receiver, the key and the labels of the group
+func groupContext(ctx context.Context, group *alertGroup) context.Context {
+	groupCtx := notify.WithReceiverName(ctx, group.receiver)
+	groupCtx = notify.WithGroupKey(groupCtx, group.groupKey)
+
+	return notify.WithGroupLabels(groupCtx, group.groupLabels)
+}
+
+// groupAcked checks, if all alerts are acknowledged and firing
+func groupAcked(alerts []*am_types.Alert, now time.Time) bool {
+	for _, alert := range alerts {
+		if alert.Annotations[configs.AckedByAnnotation] == "" || alert.ResolvedAt(now) {
+			return false
+		}
+	}
+
+	return len(alerts) > 0
 }
 
 func ApiAlertToPromAlert(alert api.GettableAlert) *am_types.Alert {
@@ -298,8 +610,40 @@ func ApiAlertToPromAlert(alert api.GettableAlert) *am_types.Alert {
 }
 
 func (s *Notify) getAlerts(ctx context.Context) (*api.GettableAlerts, error) {
-	alertsResp, err := s.alertClient.GetAlertsWithResponse(
-		ctx, &api.GetAlertsParams{},
+	return GetAlerts(ctx, s.alertClient)
+}
+
+// GetAlerts gets the alerts of all tenants from the multi-tenant alerts API
+func GetAlerts(ctx context.Context, alertClient *api.ClientWithResponses) (*api.GettableAlerts, error) {
+	alertsResp, err := getAlertsResponse(ctx, alertClient)
+	if err != nil {
+		return nil, err
+	}
+
+	return alertsResp.JSON200, nil
+}
+
+// GetPartialAlerts gets the alerts of the available tenants and the tenants, which can't be fetched, from the multi-tenant alerts API
+func GetPartialAlerts(ctx context.Context, alertClient *api.ClientWithResponses) (*api.GettableAlerts, []string, error) {
+	alertsResp, err := getAlertsResponse(ctx, alertClient, func(_ context.Context, req *http.Request) error {
+		req.Header.Set(configs.HttpHeaderPartialResponse, "true")
+		return nil
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+	var failedTenants []string
+	if header := alertsResp.HTTPResponse.Header.Get(configs.HttpHeaderFailedTenants); header != "" {
+		failedTenants = strings.Split(header, ",")
+	}
+
+	return alertsResp.JSON200, failedTenants, nil
+}
+
+func getAlertsResponse(ctx context.Context, alertClient *api.ClientWithResponses, reqEditors ...api.RequestEditorFn,
+) (*api.GetAlertsResponse, error) {
+	alertsResp, err := alertClient.GetAlertsWithResponse(
+		ctx, &api.GetAlertsParams{}, reqEditors...,
 	)
 	if err != nil {
 		return nil, logger.Wrap(ErrAlertmanagerResponse, err)
@@ -308,7 +652,7 @@ func (s *Notify) getAlerts(ctx context.Context) (*api.GettableAlerts, error) {
 		return nil, logger.Wrap(ErrInvalidResponseStatus, errors.New(alertsResp.HTTPResponse.Status))
 	}
 
-	return alertsResp.JSON200, nil
+	return alertsResp, nil
 }
 
 // https://github.com/grafana/mimir/blob/e57c02c519455f5ec0156017e6998f0330a61c9c/vendor/github.com/grafana/alerting/receivers/email/email.go#L77
@@ -322,6 +666,99 @@ func registerSprig(text *text_tmpl.Template, html *html_tmpl.Template) {
 	}).Funcs(html_tmpl.FuncMap(sprig.FuncMap()))
 }
 
+// registerTenantMeta registers the tenantMeta template function, for example: {{ tenantMeta "devops" "owner" }}
+func registerTenantMeta(alertsConfig *configs.AlertsConfig) template.Option {
+	return func(text *text_tmpl.Template, html *html_tmpl.Template) {
+		text.Funcs(text_tmpl.FuncMap{"tenantMeta": alertsConfig.GetTenantMeta})
+		html.Funcs(html_tmpl.FuncMap{"tenantMeta": alertsConfig.GetTenantMeta})
+	}
+}
+
+// registerUiLinks registers the template functions, which link to the web UI, for example: {{ uiAlertURL .Fingerprint }}
+func registerUiLinks(externalURL string) template.Option {
+	uiURL := newUiURL(externalURL)
+	uiAlertURL := func(fingerprint string) string {
+		alertURL, err := url.JoinPath(externalURL, configs.ServiceNameWebUI, "alert.html")
+		if err != nil {
+			return ""
+		}
+		return alertURL + "?" + url.Values{"fingerprint": []string{fingerprint}}.Encode()
+	}
+
+	return func(text *text_tmpl.Template, html *html_tmpl.Template) {
+		text.Funcs(text_tmpl.FuncMap{"uiURL": uiURL, "uiAlertURL": uiAlertURL})
+		html.Funcs(html_tmpl.FuncMap{"uiURL": uiURL, "uiAlertURL": uiAlertURL})
+	}
+}
+
+// newUiURL returns the function, which returns the URL of the web UI
+func newUiURL(externalURL string) func() string {
+	return func() string {
+		uiURL, err := url.JoinPath(externalURL, configs.ServiceNameWebUI, "/")
+		if err != nil {
+			return ""
+		}
+		return uiURL
+	}
+}
+
+// registerActionLinks registers the template functions, which return the signed action links of an alert,
+// for example in {{ range .Alerts }}: {{ actionSilenceURL . "2h" }}, {{ actionAckURL . }} and {{ actionViewURL . }}
+// The functions return empty string, if the action links are disabled.
+func registerActionLinks(alertsConfig *configs.AlertsConfig, externalURL string) template.Option {
+	actionURL := newActionURL(alertsConfig, externalURL)
+	funcs := map[string]any{
+		"actionSilenceURL": func(alert any, duration string) string {
+			return actionURL(actionlink.ActionSilence, templateAlert(alert), duration)
+		},
+		"actionAckURL": func(alert any) string {
+			return actionURL(actionlink.ActionAck, templateAlert(alert), "")
+		},
+		"actionViewURL": func(alert any) string {
+			return actionURL(actionlink.ActionView, templateAlert(alert), "")
+		},
+	}
+
+	return func(text *text_tmpl.Template, html *html_tmpl.Template) {
+		text.Funcs(text_tmpl.FuncMap(funcs))
+		html.Funcs(html_tmpl.FuncMap(funcs))
+	}
+}
+
+// newActionURL returns the function, which returns the signed action link of an alert, or empty string,
+// if the action links are disabled
+func newActionURL(alertsConfig *configs.AlertsConfig, externalURL string) func(action string, alert template.Alert, duration string) string {
+	secret := ""
+	tenantLabel := configs.DefaultTenantLabel
+	ttl := actionlink.DefaultTTL
+	if alertsConfig != nil {
+		secret = alertsConfig.ActionLinkSecret
+		if alertsConfig.TenantLabel != "" {
+			tenantLabel = alertsConfig.TenantLabel
+		}
+		if alertsConfig.ActionLinkTTLSec > 0 {
+			ttl = time.Duration(alertsConfig.ActionLinkTTLSec) * time.Second
+		}
+	}
+
+	return func(action string, alert template.Alert, duration string) string {
+		if secret == "" {
+			return ""
+		}
+		link, err := actionlink.URL(externalURL, secret, actionlink.Link{
+			Action:      action,
+			Tenant:      alert.Labels[tenantLabel],
+			Fingerprint: alert.Fingerprint,
+			Duration:    duration,
+			Expires:     time.Now().Add(ttl),
+		})
+		if err != nil {
+			return ""
+		}
+		return link
+	}
+}
+
 // subjectTemplateFunc sets the subject template (value) on the map represented by `.Subject.` (obj) so that it can be compiled and executed later.
 // In addition, it executes and returns the subject template using the data represented in `.TemplateData` (data).
 // This results in the template being replaced by the subject string.
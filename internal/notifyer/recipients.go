@@ -0,0 +1,128 @@
+package alertmanager
+
+import (
+	"context"
+	"log/slog"
+	"maps"
+	"net/mail"
+	"slices"
+	"strings"
+
+	am_config "github.com/prometheus/alertmanager/config"
+	"github.com/prometheus/alertmanager/notify"
+	"github.com/prometheus/alertmanager/template"
+
+	"github.com/pgillich/micro-server/pkg/logger"
+
+	"github.com/pgillich/mimir-multitenant_alertmanager/configs"
+)
+
+// notifyRecipients notifies the group by the email configs of the receiver, sent to the resolved recipients.
+// The fallback receiver is notified, if no allowed To address is resolved.
+func (n *Notify) notifyRecipients(ctx context.Context, group *alertGroup, recipients configs.RecipientsConfig) error {
+	_, log := logger.FromContext(ctx, "receiver", group.receiver, "groupKey", group.groupKey)
+	templates := n.templates.Load()
+	data := notify.GetTemplateData(groupContext(ctx, group), templates.template, group.alerts, n.goKitLog)
+	to := resolveAddresses(log, templates.template, recipients.To, data, recipients.AllowedDomains, nil)
+	cc := resolveAddresses(log, templates.template, recipients.Cc, data, recipients.AllowedDomains, to)
+	if len(to) == 0 {
+		if recipients.FallbackReceiver == "" {
+			log.Warn("No recipients, notification dropped", "alerts", len(group.alerts))
+
+			return nil
+		}
+		log.Warn("No recipients, fallback receiver notified", "fallbackReceiver", recipients.FallbackReceiver)
+		fallback := *group
+		fallback.receiver = recipients.FallbackReceiver
+
+		return n.notifyIntegrations(ctx, &fallback, templates.notifiers[fallback.receiver])
+	}
+	log.Debug("Recipients resolved", "to", to, "cc", cc)
+
+	notifiers := []notify.Notifier{}
+	dryRun := n.config.DryRun || slices.Contains(n.config.DryRunReceivers, group.receiver)
+	grafana := slices.Contains(n.config.GrafanaReceivers, group.receiver)
+	for _, receiver := range n.config.Receivers {
+		if receiver.Name != group.receiver {
+			continue
+		}
+		for e, emailConfig := range receiver.EmailConfigs {
+			notifiers = append(notifiers, newEmailNotifier(recipientsEmailConfig(emailConfig, to, cc), e, dryRun, grafana,
+				templates.template, n.dryRunLog, n.goKitLog))
+		}
+	}
+
+	return n.notifyIntegrations(ctx, group, notifiers)
+}
+
+// recipientsEmailConfig returns a copy of the email config with the recipients.
+// The envelope has the Cc addresses, too.
+func recipientsEmailConfig(emailConfig *am_config.EmailConfig, to []string, cc []string) *am_config.EmailConfig {
+	recipientsEmail := *emailConfig
+	recipientsEmail.Headers = maps.Clone(emailConfig.Headers)
+	if recipientsEmail.Headers == nil {
+		recipientsEmail.Headers = map[string]string{}
+	}
+	recipientsEmail.To = strings.Join(append(slices.Clone(to), cc...), ", ")
+	recipientsEmail.Headers["To"] = strings.Join(to, ", ")
+	delete(recipientsEmail.Headers, "Cc")
+	if len(cc) > 0 {
+		recipientsEmail.Headers["Cc"] = strings.Join(cc, ", ")
+	}
+
+	return &recipientsEmail
+}
+
+// resolveAddresses renders the comma separated addresses and returns the valid, allowed and deduplicated ones,
+// which are not excluded. The addresses are lowercased, the display names are dropped.
+// The domains of the static (non-templated) addresses are not checked.
+func resolveAddresses(log *slog.Logger, tmpl *template.Template, text string, data *template.Data, allowedDomains []string,
+	excluded []string,
+) []string {
+	if text == "" {
+		return nil
+	}
+	rendered, err := tmpl.ExecuteTextString(text, data)
+	if err != nil {
+		log.Error("Unable to render recipients", logger.KeyError, err)
+
+		return nil
+	}
+
+	templated := strings.Contains(text, "{{")
+	addresses := []string{}
+	for _, item := range strings.FieldsFunc(rendered, func(r rune) bool { return r == ',' || r == ';' || r == '\n' }) {
+		if item = strings.TrimSpace(item); item == "" {
+			continue
+		}
+		address, err := mail.ParseAddress(item)
+		if err != nil || strings.Contains(address.Address, "{{") { // the address is rendered again by the notifier
+			log.Warn("Invalid recipient", "address", item, logger.KeyError, err)
+
+			continue
+		}
+		normalized := strings.ToLower(address.Address)
+		if templated && !allowedDomain(normalized, allowedDomains) {
+			log.Warn("Recipient domain is not allowed", "address", normalized)
+
+			continue
+		}
+		if !slices.Contains(addresses, normalized) && !slices.Contains(excluded, normalized) {
+			addresses = append(addresses, normalized)
+		}
+	}
+
+	return addresses
+}
+
+// allowedDomain checks, if the domain of the address is an allowed domain or its subdomain.
+// No domain is allowed, if allowedDomains is empty.
+func allowedDomain(address string, allowedDomains []string) bool {
+	domain := address[strings.LastIndex(address, "@")+1:]
+
+	return slices.ContainsFunc(allowedDomains, func(allowed string) bool {
+		allowed = strings.ToLower(allowed)
+
+		return domain == allowed || strings.HasSuffix(domain, "."+allowed)
+	})
+}
@@ -0,0 +1,272 @@
+package alertmanager
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	html_tmpl "html/template"
+	"log/slog"
+	"net/http"
+	"regexp"
+	"slices"
+	"strconv"
+	text_tmpl "text/template"
+
+	am_config "github.com/prometheus/alertmanager/config"
+	"github.com/prometheus/alertmanager/notify"
+	am_types "github.com/prometheus/alertmanager/types"
+	prom_model "github.com/prometheus/common/model"
+
+	"github.com/pgillich/micro-server/pkg/logger"
+
+	"github.com/pgillich/mimir-multitenant_alertmanager/configs"
+	api "github.com/pgillich/mimir-multitenant_alertmanager/pkg/api/notifyer"
+)
+
+const (
+	TemplateNameSubject = "subject"
+	TemplateNameText    = "text"
+	TemplateNameHtml    = "html"
+)
+
+var (
+	ErrTemplate      = errors.New("template error")
+	ErrNoEmailConfig = errors.New("receiver has no email config")
+
+	// templateErrorRegexp parses the errors of text/template and html/template, for example:
+	// template: email.tmpl:12: function "foo" not defined
+	// template: text:3:15: executing "text" at <.Foo>: can't evaluate field Foo
+	// html/template:html:1:11: no such template "bar"
+	templateErrorRegexp = regexp.MustCompile(`(?s)^(?:html/)?template: ?([^:]*):(\d+)(?::(\d+))?: (.*)$`)
+)
+
+// RenderTemplates renders the subject, text and HTML of the notification the way the email notifier does.
+// The template sources and the message templates of the request override the configured ones.
+// The template errors are returned with ErrTemplate. The receiver of the root route is used, if the request has no receiver.
+func RenderTemplates(ctx context.Context, alertsConfig *configs.AlertsConfig, notifyerConfig *configs.NotifyerConfig,
+	request api.TemplateRenderRequest, alerts api.GettableAlerts,
+) (*api.TemplateRender, api.TemplateErrors, error) {
+	_, log := logger.FromContext(ctx)
+	var textTemplate *text_tmpl.Template
+	var htmlTemplate *html_tmpl.Template
+	tmpl, err := newTemplate(alertsConfig, notifyerConfig.ExternalURL, notifyerConfig.GrafanaURL,
+		func(text *text_tmpl.Template, html *html_tmpl.Template) {
+			textTemplate, htmlTemplate = text, html
+		})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	sources := []api.TemplateSource{}
+	if request.Templates != nil {
+		sources = *request.Templates
+	} else {
+		for t, content := range notifyerConfig.Templates {
+			sources = append(sources, api.TemplateSource{Name: fmt.Sprintf("templates[%d]", t), Content: content})
+		}
+		files, err := readTemplateFiles(notifyerConfig.TemplateFiles)
+		if err != nil {
+			return nil, nil, err
+		}
+		for f, content := range files.contents {
+			sources = append(sources, api.TemplateSource{Name: files.files[f], Content: content})
+		}
+	}
+	templateErrors := api.TemplateErrors{}
+	for _, source := range sources {
+		// Each source is parsed into its own named template, so the errors refer to the source name
+		if _, err := textTemplate.New(source.Name).Parse(source.Content); err != nil {
+			templateErrors = append(templateErrors, newTemplateError(source.Name, err))
+		} else if _, err := htmlTemplate.New(source.Name).Parse(source.Content); err != nil {
+			templateErrors = append(templateErrors, newTemplateError(source.Name, err))
+		}
+	}
+	if len(templateErrors) > 0 {
+		return nil, templateErrors, ErrTemplate
+	}
+
+	render := &api.TemplateRender{}
+	if request.Receiver != nil {
+		render.Receiver = *request.Receiver
+	} else if notifyerConfig.Route != nil {
+		render.Receiver = notifyerConfig.Route.Receiver
+	} else {
+		return nil, nil, ErrMissingRoute
+	}
+	emailConfig, err := receiverEmailConfig(notifyerConfig.Receivers, render.Receiver)
+	if err != nil && (request.Subject == nil || request.Text == nil || request.Html == nil) {
+		return nil, nil, err
+	}
+	messageTemplates := map[string]string{}
+	if emailConfig != nil {
+		messageTemplates[TemplateNameSubject] = am_config.DefaultEmailSubject
+		if subject, has := emailConfig.Headers["Subject"]; has {
+			messageTemplates[TemplateNameSubject] = subject
+		}
+		messageTemplates[TemplateNameText] = emailConfig.Text
+		messageTemplates[TemplateNameHtml] = emailConfig.HTML
+	}
+	for name, value := range map[string]*string{
+		TemplateNameSubject: request.Subject, TemplateNameText: request.Text, TemplateNameHtml: request.Html,
+	} {
+		if value != nil {
+			messageTemplates[name] = *value
+		}
+	}
+
+	groupLabels := prom_model.LabelSet{}
+	if request.GroupLabels != nil {
+		for name, value := range *request.GroupLabels {
+			groupLabels[prom_model.LabelName(name)] = prom_model.LabelValue(value)
+		}
+	}
+	promAlerts := make([]*am_types.Alert, 0, len(alerts))
+	for _, alert := range alerts {
+		promAlerts = append(promAlerts, ApiAlertToPromAlert(alert))
+	}
+	ctx = notify.WithReceiverName(ctx, render.Receiver)
+	ctx = notify.WithGroupLabels(ctx, groupLabels)
+	data := notify.GetTemplateData(ctx, tmpl, promAlerts, &GoKitAdapter{
+		Ctx: ctx, Logger: log, LogLevel: slog.LevelWarn, Message: "RenderTemplates",
+	})
+
+	// The subject is a header, so it's rendered as text, like by the email notifier
+	if !slices.Contains(notifyerConfig.GrafanaReceivers, render.Receiver) {
+		render.Subject = executeTextTemplate(textTemplate, TemplateNameSubject, messageTemplates[TemplateNameSubject], data, &templateErrors)
+		render.Text = executeTextTemplate(textTemplate, TemplateNameText, messageTemplates[TemplateNameText], data, &templateErrors)
+		render.Html = executeHtmlTemplate(htmlTemplate, TemplateNameHtml, messageTemplates[TemplateNameHtml], data, &templateErrors)
+	} else {
+		// The messages of the Grafana receivers are executed on the Grafana template data, the .Title is the rendered subject
+		extendedData := newExtendedData(alertsConfig, notifyerConfig.ExternalURL, notifyerConfig.GrafanaURL)
+		render.Subject = executeTextTemplate(textTemplate, TemplateNameSubject, messageTemplates[TemplateNameSubject],
+			extendedData(data, ""), &templateErrors)
+		render.Text = executeTextTemplate(textTemplate, TemplateNameText, messageTemplates[TemplateNameText],
+			extendedData(data, render.Subject), &templateErrors)
+		render.Html = executeHtmlTemplate(htmlTemplate, TemplateNameHtml, messageTemplates[TemplateNameHtml],
+			extendedData(data, render.Subject), &templateErrors)
+	}
+	if len(templateErrors) > 0 {
+		return nil, templateErrors, ErrTemplate
+	}
+
+	return render, nil, nil
+}
+
+func receiverEmailConfig(receivers []am_config.Receiver, name string) (*am_config.EmailConfig, error) {
+	for _, receiver := range receivers {
+		if receiver.Name == name && len(receiver.EmailConfigs) > 0 {
+			return receiver.EmailConfigs[0], nil
+		}
+	}
+
+	return nil, logger.Wrap(ErrNoEmailConfig, errors.New(name))
+}
+
+func executeTextTemplate(textTemplate *text_tmpl.Template, name string, content string, data any, templateErrors *api.TemplateErrors) string {
+	if content == "" {
+		return ""
+	}
+	tmpl, err := textTemplate.Clone()
+	if err == nil {
+		tmpl, err = tmpl.New(name).Option("missingkey=zero").Parse(content)
+	}
+	var buf bytes.Buffer
+	if err == nil {
+		err = tmpl.Execute(&buf, data)
+	}
+	if err != nil {
+		*templateErrors = append(*templateErrors, newTemplateError(name, err))
+	}
+
+	return buf.String()
+}
+
+func executeHtmlTemplate(htmlTemplate *html_tmpl.Template, name string, content string, data any, templateErrors *api.TemplateErrors) string {
+	if content == "" {
+		return ""
+	}
+	tmpl, err := htmlTemplate.Clone()
+	if err == nil {
+		tmpl, err = tmpl.New(name).Option("missingkey=zero").Parse(content)
+	}
+	var buf bytes.Buffer
+	if err == nil {
+		err = tmpl.Execute(&buf, data)
+	}
+	if err != nil {
+		*templateErrors = append(*templateErrors, newTemplateError(name, err))
+	}
+
+	return buf.String()
+}
+
+// newTemplateError extracts the template name, line and column from the error message
+func newTemplateError(name string, err error) api.TemplateError {
+	templateError := api.TemplateError{Template: name, Message: err.Error()}
+	if match := templateErrorRegexp.FindStringSubmatch(err.Error()); match != nil {
+		if match[1] != "" {
+			templateError.Template = match[1]
+		}
+		if line, err := strconv.Atoi(match[2]); err == nil {
+			templateError.Line = &line
+		}
+		if column, err := strconv.Atoi(match[3]); err == nil {
+			templateError.Column = &column
+		}
+		templateError.Message = match[4]
+	}
+
+	return templateError
+}
+
+func (s *ApiServer) RenderTemplates(w http.ResponseWriter, r *http.Request) {
+	_, log := logger.FromContext(r.Context())
+	notifier := s.service.notify
+
+	var request api.TemplateRenderRequest
+	if err := json.NewDecoder(r.Body).Decode(&request); err != nil {
+		log.Warn("Unable to decode request", logger.KeyError, err)
+		if err = api.RenderTemplates400JSONResponse(api.TemplateErrors{{Message: logger.Wrap(ErrInvalidRequest, err).Error()}}).
+			VisitRenderTemplatesResponse(w); err != nil {
+			log.Error("Unable to render error response", logger.KeyError, logger.Wrap(ErrRenderResponse, err))
+		}
+		return
+	}
+
+	var alerts api.GettableAlerts
+	if request.Alerts != nil {
+		alerts = *request.Alerts
+	} else {
+		liveAlerts, err := notifier.getAlerts(r.Context())
+		if err != nil {
+			log.Error("Unable to get alerts", logger.KeyError, err)
+			if err = api.RenderTemplates500JSONResponse(err.Error()).VisitRenderTemplatesResponse(w); err != nil {
+				log.Error("Unable to render error response", logger.KeyError, logger.Wrap(ErrRenderResponse, err))
+			}
+			return
+		}
+		alerts = *liveAlerts
+	}
+
+	render, templateErrors, err := RenderTemplates(r.Context(), notifier.alertsConfig, notifier.config, request, alerts)
+	switch {
+	case errors.Is(err, ErrTemplate):
+		log.Info("Template errors", "errors", len(templateErrors))
+		if err = api.RenderTemplates400JSONResponse(templateErrors).VisitRenderTemplatesResponse(w); err != nil {
+			log.Error("Unable to render error response", logger.KeyError, logger.Wrap(ErrRenderResponse, err))
+		}
+		return
+	case err != nil:
+		log.Error("Unable to render templates", logger.KeyError, err)
+		if err = api.RenderTemplates500JSONResponse(err.Error()).VisitRenderTemplatesResponse(w); err != nil {
+			log.Error("Unable to render error response", logger.KeyError, logger.Wrap(ErrRenderResponse, err))
+		}
+		return
+	}
+
+	if err := api.RenderTemplates200JSONResponse(*render).VisitRenderTemplatesResponse(w); err != nil {
+		log.Error("Unable to render response", logger.KeyError, logger.Wrap(ErrRenderResponse, err))
+	}
+}
@@ -0,0 +1,221 @@
+package alertmanager
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"slices"
+	"sort"
+	"time"
+
+	am_config "github.com/prometheus/alertmanager/config"
+	"github.com/prometheus/alertmanager/dispatch"
+	prom_model "github.com/prometheus/common/model"
+
+	"github.com/pgillich/micro-server/pkg/logger"
+
+	"github.com/pgillich/mimir-multitenant_alertmanager/configs"
+	api "github.com/pgillich/mimir-multitenant_alertmanager/pkg/api/notifyer"
+)
+
+var (
+	ErrMissingRoute  = errors.New("missing route")
+	ErrAlertNotFound = errors.New("alert not found")
+)
+
+// NewRouteTree builds the routing tree of the Alertmanager dispatcher from the route config.
+// The group_by strings are resolved here, because they are set by YAML unmarshal only.
+func NewRouteTree(route *am_config.Route) (*dispatch.Route, error) {
+	if route == nil {
+		return nil, ErrMissingRoute
+	}
+
+	return dispatch.NewRoute(resolveGroupBy(route), nil), nil
+}
+
+// resolveGroupBy returns a copy of the route tree with GroupBy and GroupByAll filled from GroupByStr
+func resolveGroupBy(route *am_config.Route) *am_config.Route {
+	resolved := *route
+	if resolved.GroupBy == nil && len(resolved.GroupByStr) > 0 {
+		// Like config.Route.UnmarshalYAML, GroupBy is left nil for grouping by all labels
+		if slices.Contains(resolved.GroupByStr, configs.GroupByAll) {
+			resolved.GroupByAll = true
+		} else {
+			for _, label := range resolved.GroupByStr {
+				resolved.GroupBy = append(resolved.GroupBy, prom_model.LabelName(label))
+			}
+		}
+	}
+	resolved.Routes = make([]*am_config.Route, 0, len(route.Routes))
+	for _, child := range route.Routes {
+		resolved.Routes = append(resolved.Routes, resolveGroupBy(child))
+	}
+
+	return &resolved
+}
+
+// RouteTreeNode converts the routing tree to the API model
+func RouteTreeNode(route *dispatch.Route) api.RouteNode {
+	node := api.RouteNode{
+		Id:             route.ID(),
+		Matchers:       make([]string, 0, len(route.Matchers)),
+		Receiver:       route.RouteOpts.Receiver,
+		GroupBy:        routeGroupBy(route.RouteOpts),
+		GroupByAll:     route.RouteOpts.GroupByAll,
+		GroupWait:      formatDuration(route.RouteOpts.GroupWait),
+		GroupInterval:  formatDuration(route.RouteOpts.GroupInterval),
+		RepeatInterval: formatDuration(route.RouteOpts.RepeatInterval),
+		Continue:       route.Continue,
+		Routes:         make([]api.RouteNode, 0, len(route.Routes)),
+	}
+	for _, matcher := range route.Matchers {
+		node.Matchers = append(node.Matchers, matcher.String())
+	}
+	if len(route.RouteOpts.MuteTimeIntervals) > 0 {
+		node.MuteTimeIntervals = &route.RouteOpts.MuteTimeIntervals
+	}
+	if len(route.RouteOpts.ActiveTimeIntervals) > 0 {
+		node.ActiveTimeIntervals = &route.RouteOpts.ActiveTimeIntervals
+	}
+	for _, child := range route.Routes {
+		node.Routes = append(node.Routes, RouteTreeNode(child))
+	}
+
+	return node
+}
+
+// TestRoutes returns the routes matching the label set, with the receivers, group keys and timing settings.
+// The routes are matched the same way as by the Alertmanager dispatcher.
+func TestRoutes(tree *dispatch.Route, labels api.LabelSet) api.RouteTestResult {
+	labelSet := prom_model.LabelSet{}
+	for name, value := range labels {
+		labelSet[prom_model.LabelName(name)] = prom_model.LabelValue(value)
+	}
+	result := api.RouteTestResult{Labels: labels, Matches: []api.RouteMatch{}}
+
+	for _, route := range tree.Match(labelSet) {
+		groupLabels := routeGroupLabels(route.RouteOpts, labelSet)
+		match := api.RouteMatch{
+			Id:             route.ID(),
+			Path:           routePath(tree, route, []string{}),
+			Receiver:       route.RouteOpts.Receiver,
+			GroupBy:        routeGroupBy(route.RouteOpts),
+			GroupByAll:     route.RouteOpts.GroupByAll,
+			GroupKey:       fmt.Sprintf("%s:%s", route.Key(), groupLabels),
+			GroupLabels:    api.LabelSet{},
+			GroupWait:      formatDuration(route.RouteOpts.GroupWait),
+			GroupInterval:  formatDuration(route.RouteOpts.GroupInterval),
+			RepeatInterval: formatDuration(route.RouteOpts.RepeatInterval),
+		}
+		for name, value := range groupLabels {
+			match.GroupLabels[string(name)] = string(value)
+		}
+		if len(route.RouteOpts.MuteTimeIntervals) > 0 {
+			match.MuteTimeIntervals = &route.RouteOpts.MuteTimeIntervals
+		}
+		if len(route.RouteOpts.ActiveTimeIntervals) > 0 {
+			match.ActiveTimeIntervals = &route.RouteOpts.ActiveTimeIntervals
+		}
+		result.Matches = append(result.Matches, match)
+	}
+
+	return result
+}
+
+// routePath returns the matchers of the routes from the root to the target route, nil if not found
+func routePath(route *dispatch.Route, target *dispatch.Route, path []string) []string {
+	path = append(slices.Clone(path), route.Matchers.String())
+	if route == target {
+		return path
+	}
+	for _, child := range route.Routes {
+		if found := routePath(child, target, path); found != nil {
+			return found
+		}
+	}
+
+	return nil
+}
+
+// routeGroupLabels selects the group labels of an alert, like the Alertmanager dispatcher does
+func routeGroupLabels(opts dispatch.RouteOpts, labelSet prom_model.LabelSet) prom_model.LabelSet {
+	groupLabels := prom_model.LabelSet{}
+	for name, value := range labelSet {
+		if _, has := opts.GroupBy[name]; has || opts.GroupByAll {
+			groupLabels[name] = value
+		}
+	}
+
+	return groupLabels
+}
+
+func routeGroupBy(opts dispatch.RouteOpts) []string {
+	groupBy := make([]string, 0, len(opts.GroupBy))
+	for name := range opts.GroupBy {
+		groupBy = append(groupBy, string(name))
+	}
+	sort.Strings(groupBy)
+
+	return groupBy
+}
+
+func formatDuration(duration time.Duration) string {
+	return prom_model.Duration(duration).String()
+}
+
+// FindAlert looks for the alert by fingerprint
+func FindAlert(alerts api.GettableAlerts, fingerprint string) (api.GettableAlert, error) {
+	for _, alert := range alerts {
+		if alert.Fingerprint == fingerprint {
+			return alert, nil
+		}
+	}
+
+	return api.GettableAlert{}, logger.Wrap(ErrAlertNotFound, errors.New(fingerprint))
+}
+
+func (s *ApiServer) GetRoutes(w http.ResponseWriter, r *http.Request) {
+	_, log := logger.FromContext(r.Context())
+
+	if err := api.GetRoutes200JSONResponse(RouteTreeNode(s.service.notify.routeTree)).VisitGetRoutesResponse(w); err != nil {
+		log.Error("Unable to render response", logger.KeyError, logger.Wrap(ErrRenderResponse, err))
+	}
+}
+
+func (s *ApiServer) TestRoutes(w http.ResponseWriter, r *http.Request) {
+	_, log := logger.FromContext(r.Context())
+
+	var request api.RouteTestRequest
+	err := json.NewDecoder(r.Body).Decode(&request)
+	if err == nil && (request.Labels == nil) == (request.Fingerprint == nil) {
+		err = errors.New("either labels or fingerprint must be given")
+	}
+	if err != nil {
+		log.Warn("Invalid request", logger.KeyError, err)
+		if err = api.TestRoutes400JSONResponse(logger.Wrap(ErrInvalidRequest, err).Error()).VisitTestRoutesResponse(w); err != nil {
+			log.Error("Unable to render error response", logger.KeyError, logger.Wrap(ErrRenderResponse, err))
+		}
+		return
+	}
+
+	var labels api.LabelSet
+	if request.Labels != nil {
+		labels = *request.Labels
+	} else {
+		alert, has := (*s.service.notify.lastAlerts.Load())[*request.Fingerprint]
+		if !has {
+			err = logger.Wrap(ErrAlertNotFound, errors.New(*request.Fingerprint))
+			log.Info("Unable to test routes", logger.KeyError, err)
+			if err = api.TestRoutes404JSONResponse(err.Error()).VisitTestRoutesResponse(w); err != nil {
+				log.Error("Unable to render error response", logger.KeyError, logger.Wrap(ErrRenderResponse, err))
+			}
+			return
+		}
+		labels = alert.Labels
+	}
+
+	if err := api.TestRoutes200JSONResponse(TestRoutes(s.service.notify.routeTree, labels)).VisitTestRoutesResponse(w); err != nil {
+		log.Error("Unable to render response", logger.KeyError, logger.Wrap(ErrRenderResponse, err))
+	}
+}
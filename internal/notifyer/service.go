@@ -5,11 +5,11 @@ import (
 	"errors"
 	"path"
 	"sync/atomic"
+	"time"
 
 	"github.com/go-chi/chi/v5"
-	am_config "github.com/prometheus/alertmanager/config"
-	"github.com/prometheus/alertmanager/notify"
-	"github.com/prometheus/alertmanager/template"
+	"github.com/prometheus/alertmanager/dispatch"
+	"github.com/prometheus/alertmanager/timeinterval"
 	"go.opentelemetry.io/otel/trace"
 
 	srv_configs "github.com/pgillich/micro-server/pkg/configs"
@@ -40,14 +40,34 @@ type HttpService struct {
 type Notify struct {
 	testConfig *configs.TestConfig
 
-	config           *configs.NotifyerConfig
-	alertClient      *api.ClientWithResponses
-	lastAlerts       atomic.Pointer[map[string]api.GettableAlert]
-	receiver         *am_config.Receiver
-	notifier         notify.Notifier
-	resolvedNotifier notify.Notifier
-	template         *template.Template
-	tr               trace.Tracer
+	config       *configs.NotifyerConfig
+	alertsConfig *configs.AlertsConfig
+	alertClient  *api.ClientWithResponses
+	lastAlerts   atomic.Pointer[map[string]api.GettableAlert]
+	// missing is the number of the polls by fingerprint, while an unresolved alert is missing
+	missing   map[string]int
+	routeTree *dispatch.Route
+	// templates is the active template set with its notifiers, swapped on template file changes
+	templates      atomic.Pointer[templateSet]
+	templateStatus atomic.Pointer[api.TemplateStatus]
+	// failedTemplates is the checksum of the template files, which can't be loaded
+	failedTemplates string
+	// recipients are the dynamic recipients by receiver
+	recipients  map[string]configs.RecipientsConfig
+	goKitLog    *GoKitAdapter
+	tr          trace.Tracer
+	tenantLabel string
+	events      *EventBroker
+	dryRunLog   *DryRunLog
+	history     *HistoryStore
+	flaps       *FlapDetector
+	intervener  *timeinterval.Intervener
+	held        map[string]*heldGroup
+	escalations *Escalator
+	digests     *Digester
+	storms      *StormGuard
+	changes     *ChangeDetector
+	now         func() time.Time
 }
 
 func newHttpService() model.HttpServicer {
@@ -76,6 +96,9 @@ func (s *HttpService) Prepare(ctx context.Context, serverConfiger srv_configs.Se
 	if !is {
 		return srv_configs.ErrFatalServerConfig
 	}
+	if err := append(serverConfig.Validate(), ValidateConfig(serverConfig.Alerts, serverConfig.Notifyer)...).Err(); err != nil {
+		return logger.Wrap(ErrUnableToPrepareService, err)
+	}
 
 	api.HandlerWithOptions(s.apiServer, api.ChiServerOptions{
 		BaseURL:    path.Join("/", configs.ServiceNameNotifyer, "/api/v2"),
@@ -0,0 +1,124 @@
+package alertmanager
+
+import (
+	"context"
+	"strconv"
+	"time"
+
+	prom_model "github.com/prometheus/common/model"
+
+	"github.com/pgillich/mimir-multitenant_alertmanager/configs"
+	api "github.com/pgillich/mimir-multitenant_alertmanager/pkg/api/notifyer"
+)
+
+// simulationLogSize is the dry-run log size of the simulator, the notifications of a step must fit into it
+const simulationLogSize = 10000
+
+// SimulationStep is an alert snapshot, evaluated at the virtual time
+type SimulationStep struct {
+	Time   time.Time
+	Source string
+	Alerts api.GettableAlerts
+	// FailedTenants are the tenants, which can't be fetched at the step
+	FailedTenants []string
+}
+
+// TimelineEntry is the result of a simulation step
+type TimelineEntry struct {
+	Step          int                      `yaml:"step" json:"step"`
+	Time          time.Time                `yaml:"time" json:"time"`
+	Source        string                   `yaml:"source,omitempty" json:"source,omitempty"`
+	Alerts        int                      `yaml:"alerts" json:"alerts"`
+	Firing        int                      `yaml:"firing" json:"firing"`
+	Resolved      int                      `yaml:"resolved" json:"resolved"`
+	Notifications []api.DryRunNotification `yaml:"notifications" json:"notifications"`
+	// Escalations are the escalation states after the step
+	Escalations []api.Escalation `yaml:"escalations,omitempty" json:"escalations,omitempty"`
+}
+
+// Simulator replays alert snapshots through the notifyer logic with a virtual clock.
+// All receivers are in dry-run mode, so the notifications are recorded instead of sending.
+type Simulator struct {
+	notify *Notify
+	lastID uint64
+	steps  int
+}
+
+func NewSimulator(ctx context.Context, alertsConfig *configs.AlertsConfig, notifyerConfig *configs.NotifyerConfig) (*Simulator, error) {
+	simulationConfig := *notifyerConfig
+	simulationConfig.DryRun = true
+	simulationConfig.DryRunLogSize = simulationLogSize
+	simulationConfig.HistoryPath = ""
+
+	notify, err := newNotify(ctx, alertsConfig, &simulationConfig)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Simulator{notify: notify}, nil
+}
+
+// Step evaluates the alert snapshot at the virtual time of the step and returns the notifications of the step
+func (s *Simulator) Step(ctx context.Context, step SimulationStep) (TimelineEntry, error) {
+	alerts := make(api.GettableAlerts, 0, len(step.Alerts))
+	for _, alert := range step.Alerts {
+		alerts = append(alerts, simulationAlert(alert, step.Time))
+	}
+
+	s.steps++
+	notifyStat, err := s.notify.processAlerts(ctx, alerts, step.FailedTenants, step.Time)
+	entry := TimelineEntry{
+		Step:          s.steps,
+		Time:          step.Time,
+		Source:        step.Source,
+		Alerts:        len(alerts),
+		Firing:        notifyStat.Firing,
+		Resolved:      notifyStat.Resolved,
+		Notifications: []api.DryRunNotification{},
+		Escalations:   s.notify.escalations.List(),
+	}
+	for _, notification := range s.notify.dryRunLog.List("") {
+		if notification.Id > s.lastID {
+			entry.Notifications = append(entry.Notifications, notification)
+			s.lastID = notification.Id
+		}
+	}
+
+	return entry, err
+}
+
+// Run evaluates the steps in order and returns the timeline
+func (s *Simulator) Run(ctx context.Context, steps []SimulationStep) ([]TimelineEntry, error) {
+	timeline := make([]TimelineEntry, 0, len(steps))
+	for _, step := range steps {
+		entry, err := s.Step(ctx, step)
+		timeline = append(timeline, entry)
+		if err != nil {
+			return timeline, err
+		}
+	}
+
+	return timeline, nil
+}
+
+// simulationAlert fills the fields of a recorded or hand-written alert, which are set by the multi-tenant alerts API.
+// The fingerprint is calculated from the labels, like the multi-tenant alerts API does.
+func simulationAlert(alert api.GettableAlert, now time.Time) api.GettableAlert {
+	alert.Fingerprint = strconv.FormatUint(prom_model.LabelsToSignature(alert.Labels), 16)
+	if alert.Status.State == "" {
+		alert.Status.State = api.Active
+	}
+	if alert.StartsAt.IsZero() {
+		alert.StartsAt = now
+	}
+	if alert.UpdatedAt.IsZero() {
+		alert.UpdatedAt = now
+	}
+
+	return alert
+}
+
+// TemplateStatus returns the status of the template files, which are reloaded at each step on change
+func (s *Simulator) TemplateStatus() api.TemplateStatus {
+	return s.notify.TemplateStatus()
+}
@@ -0,0 +1,305 @@
+package alertmanager
+
+import (
+	"cmp"
+	"context"
+	html_tmpl "html/template"
+	"log/slog"
+	"maps"
+	"slices"
+	"sync"
+	text_tmpl "text/template"
+	"time"
+
+	am_config "github.com/prometheus/alertmanager/config"
+	"github.com/prometheus/alertmanager/notify"
+	"github.com/prometheus/alertmanager/template"
+	am_types "github.com/prometheus/alertmanager/types"
+	prom_model "github.com/prometheus/common/model"
+	"go.opentelemetry.io/otel/attribute"
+	metric_api "go.opentelemetry.io/otel/metric"
+
+	"github.com/pgillich/micro-server/pkg/logger"
+	"github.com/pgillich/micro-server/pkg/middleware"
+
+	"github.com/pgillich/mimir-multitenant_alertmanager/configs"
+	"github.com/pgillich/mimir-multitenant_alertmanager/internal/buildinfo"
+)
+
+const (
+	// StormTemplateName is the built-in HTML body template of the storm summaries
+	StormTemplateName = "storm.default.html"
+	// StormSubjectTemplateName is the built-in subject template of the storm summaries
+	StormSubjectTemplateName = "storm.default.subject"
+
+	// MetricSuppressedNotifications counts the notifications suppressed by the storm protection and the change rate limit
+	MetricSuppressedNotifications = "suppressed_notifications"
+
+	SuppressReasonStorm     = "storm"
+	SuppressReasonRateLimit = "rate_limit"
+)
+
+// stormTemplates are the built-in storm summary templates, which can be overridden by the notifyer templates
+const stormTemplates = `
+{{ define "storm.default.subject" }}[STORM] {{ .Receiver }}: {{ len .Alerts.Firing }} firing, {{ len .Alerts.Resolved }} resolved alerts held back{{ end }}
+
+{{ define "storm.default.html" }}<h2>{{ template "storm.default.subject" . }}</h2>
+<table>
+<tr><th>Tenant</th><th>Alertname</th><th>Severity</th><th>Firing</th><th>Resolved</th></tr>
+{{ range stormCounts .Alerts }}<tr><td>{{ .Tenant }}</td><td>{{ .Alertname }}</td><td>{{ .Severity }}</td><td>{{ .Firing }}</td><td>{{ .Resolved }}</td></tr>
+{{ end }}</table>{{ end }}
+`
+
+// StormCount is the number of the alerts with the same tenant, alertname and severity, see the stormCounts template function
+type StormCount struct {
+	Tenant    string
+	Alertname string
+	Severity  string
+	Firing    int
+	Resolved  int
+}
+
+// StormGuard limits the notifications of the receivers, see configs.StormProtectionConfig
+type StormGuard struct {
+	mu     sync.Mutex
+	storms map[string]*stormState
+}
+
+type stormState struct {
+	config configs.StormProtectionConfig
+	// notifications are the times of the sent notifications in the window
+	notifications []time.Time
+	// batches are the number of the alerts to be notified in the window
+	batches []stormBatch
+	storm   bool
+	// heldBack are the last states of the suppressed alerts, which are sent in a catch-up summary,
+	// when the storm ends and the rate limit allows it
+	heldBack map[prom_model.Fingerprint]*am_types.Alert
+}
+
+type stormBatch struct {
+	at     time.Time
+	alerts int
+}
+
+func NewStormGuard(storms []configs.StormProtectionConfig) *StormGuard {
+	g := &StormGuard{storms: map[string]*stormState{}}
+	for _, storm := range storms {
+		g.storms[storm.Receiver] = &stormState{config: storm, heldBack: map[prom_model.Fingerprint]*am_types.Alert{}}
+	}
+
+	return g
+}
+
+// Admit returns the groups to be notified and the storm summaries.
+// The groups of a receiver in storm and the groups above the rate limit are suppressed and held back,
+// the held back alerts are returned in a catch-up summary, when the storm ends and the rate limit allows it.
+func (g *StormGuard) Admit(ctx context.Context, now time.Time, groups []*alertGroup) ([]*alertGroup, []alertGroup) {
+	_, log := logger.FromContext(ctx)
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	byReceiver := map[string][]*alertGroup{}
+	admitted := []*alertGroup{}
+	for _, group := range groups {
+		if _, has := g.storms[group.receiver]; has {
+			byReceiver[group.receiver] = append(byReceiver[group.receiver], group)
+		} else {
+			admitted = append(admitted, group)
+		}
+	}
+
+	summaries := []alertGroup{}
+	for _, receiver := range slices.Sorted(maps.Keys(g.storms)) {
+		state := g.storms[receiver]
+		receiverGroups := byReceiver[receiver]
+		state.prune(now)
+		alerts := 0
+		for _, group := range receiverGroups {
+			alerts += len(group.alerts)
+		}
+		if alerts > 0 {
+			state.batches = append(state.batches, stormBatch{at: now, alerts: alerts})
+		}
+		total := 0
+		for _, batch := range state.batches {
+			total += batch.alerts
+		}
+
+		threshold := state.config.StormThreshold
+		started := false
+		switch {
+		case !state.storm && threshold > 0 && total > threshold:
+			state.storm = true
+			started = true
+			log.Warn("Notification storm", "receiver", receiver, "alerts", total, "threshold", threshold)
+		case state.storm && total <= threshold:
+			state.storm = false
+			log.Info("Notification storm ended", "receiver", receiver, "alerts", total, "threshold", threshold)
+		}
+		if state.storm {
+			state.holdBack(receiverGroups...)
+			if started {
+				summaries = append(summaries, stormSummary(receiver, state.heldBack))
+			}
+			if len(receiverGroups) > 0 {
+				suppressed(ctx, log, receiver, SuppressReasonStorm, len(receiverGroups))
+			}
+
+			continue
+		}
+
+		if len(state.heldBack) > 0 && state.allows() {
+			log.Info("Held back notifications released", "receiver", receiver, "alerts", len(state.heldBack))
+			state.notifications = append(state.notifications, now)
+			summaries = append(summaries, stormSummary(receiver, state.heldBack))
+			state.heldBack = map[prom_model.Fingerprint]*am_types.Alert{}
+		}
+		for _, group := range receiverGroups {
+			if !state.allows() {
+				state.holdBack(group)
+				suppressed(ctx, log, receiver, SuppressReasonRateLimit, 1)
+
+				continue
+			}
+			state.notifications = append(state.notifications, now)
+			admitted = append(admitted, group)
+		}
+	}
+
+	return admitted, summaries
+}
+
+// HoldBack holds back the alerts of a failed storm summary, they are sent in the next catch-up summary
+func (g *StormGuard) HoldBack(summary *alertGroup) {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	if state, has := g.storms[summary.receiver]; has {
+		state.holdBack(summary)
+	}
+}
+
+// holdBack keeps the last states of the alerts of the groups
+func (s *stormState) holdBack(groups ...*alertGroup) {
+	for _, group := range groups {
+		for _, alert := range group.alerts {
+			s.heldBack[alert.Fingerprint()] = alert
+		}
+	}
+}
+
+// allows checks, if a notification is allowed by the rate limit
+func (s *stormState) allows() bool {
+	return s.config.MaxNotifications <= 0 || len(s.notifications) < s.config.MaxNotifications
+}
+
+// prune drops the notifications and the batches out of the window
+func (s *stormState) prune(now time.Time) {
+	windowStart := now.Add(-time.Duration(s.config.WindowSec) * time.Second)
+	s.notifications = slices.DeleteFunc(s.notifications, func(at time.Time) bool { return !at.After(windowStart) })
+	s.batches = slices.DeleteFunc(s.batches, func(batch stormBatch) bool { return !batch.at.After(windowStart) })
+}
+
+// stormSummary returns the summary group of the alerts, ordered by fingerprint
+func stormSummary(receiver string, alerts map[prom_model.Fingerprint]*am_types.Alert) alertGroup {
+	summary := alertGroup{receiver: receiver, groupKey: "storm:" + receiver, groupLabels: prom_model.LabelSet{}}
+	for _, fingerprint := range slices.Sorted(maps.Keys(alerts)) {
+		summary.alerts = append(summary.alerts, alerts[fingerprint])
+	}
+
+	return summary
+}
+
+// suppressed counts the suppressed notifications
+func suppressed(ctx context.Context, log *slog.Logger, receiver string, reason string, notifications int) {
+	log.Info("Notifications suppressed", "receiver", receiver, "reason", reason, "notifications", notifications)
+	middleware.GetMeter(buildinfo.BuildInfo, log)
+	counter, err := middleware.Int64CounterGetInstrument(MetricSuppressedNotifications,
+		metric_api.WithDescription("Notifications suppressed by the storm protection and the change rate limit"))
+	if err != nil {
+		log.Error("Unable to count suppressed notifications", logger.KeyError, err)
+
+		return
+	}
+	counter.Add(ctx, int64(notifications), metric_api.WithAttributes(
+		attribute.String("receiver", receiver), attribute.String("reason", reason),
+	))
+}
+
+// notifyGroups notifies the groups admitted by the storm protection and sends the storm summaries.
+// The escalation of the admitted groups is started. The groups, which can't be notified, are returned,
+// the alerts of a failed summary are held back by the storm protection.
+func (n *Notify) notifyGroups(ctx context.Context, now time.Time, groups []*alertGroup) ([]*alertGroup, []error) {
+	var errs []error
+	failed := []*alertGroup{}
+	admitted, summaries := n.storms.Admit(ctx, now, groups)
+	for _, group := range admitted {
+		if err := n.notifyGroup(ctx, group); err != nil {
+			errs = append(errs, err)
+			failed = append(failed, group)
+		}
+		n.escalations.Start(now, group)
+	}
+	for _, summary := range summaries {
+		if err := n.notifyIntegrations(ctx, &summary, n.templates.Load().stormNotifiers[summary.receiver]); err != nil {
+			errs = append(errs, err)
+			n.storms.HoldBack(&summary)
+		}
+	}
+
+	return failed, errs
+}
+
+// newStormNotifiers creates the email notifiers of the storm summaries
+func newStormNotifiers(log *slog.Logger, notifyerConfig *configs.NotifyerConfig, tmpl *template.Template,
+	dryRunLog *DryRunLog, goKitLog *GoKitAdapter,
+) map[string][]notify.Notifier {
+	stormConfig := *notifyerConfig
+	stormConfig.Receivers = []am_config.Receiver{}
+	for _, storm := range notifyerConfig.StormProtection {
+		for _, receiver := range notifyerConfig.Receivers {
+			if receiver.Name == storm.Receiver {
+				stormConfig.Receivers = append(stormConfig.Receivers, templatedReceiver(receiver,
+					StormTemplateName, `{{ template "`+StormSubjectTemplateName+`" . }}`))
+			}
+		}
+	}
+
+	return newNotifiers(log, &stormConfig, tmpl, dryRunLog, goKitLog)
+}
+
+// registerStorm registers the stormCounts template function, which counts the alerts by tenant, alertname and severity,
+// for example: {{ range stormCounts .Alerts }}{{ .Tenant }} {{ .Alertname }} {{ .Severity }} {{ .Firing }}{{ end }}
+func registerStorm(alertsConfig *configs.AlertsConfig) template.Option {
+	tenantLabel := tenantLabelOf(alertsConfig)
+	stormCounts := func(alerts any) []StormCount {
+		counts := map[[3]string]*StormCount{}
+		for _, alert := range templateAlerts(alerts) {
+			key := [3]string{alert.Labels[tenantLabel], alert.Labels[prom_model.AlertNameLabel], alert.Labels["severity"]}
+			count, has := counts[key]
+			if !has {
+				count = &StormCount{Tenant: key[0], Alertname: key[1], Severity: key[2]}
+				counts[key] = count
+			}
+			if alert.Status == string(prom_model.AlertResolved) {
+				count.Resolved++
+			} else {
+				count.Firing++
+			}
+		}
+		stormCounts := make([]StormCount, 0, len(counts))
+		for _, key := range slices.SortedFunc(maps.Keys(counts), func(a, b [3]string) int {
+			return cmp.Or(cmp.Compare(a[0], b[0]), cmp.Compare(a[1], b[1]), cmp.Compare(a[2], b[2]))
+		}) {
+			stormCounts = append(stormCounts, *counts[key])
+		}
+
+		return stormCounts
+	}
+
+	return func(text *text_tmpl.Template, html *html_tmpl.Template) {
+		text.Funcs(text_tmpl.FuncMap{"stormCounts": stormCounts})
+		html.Funcs(html_tmpl.FuncMap{"stormCounts": stormCounts})
+	}
+}
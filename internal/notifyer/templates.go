@@ -0,0 +1,193 @@
+package alertmanager
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/prometheus/alertmanager/notify"
+	"github.com/prometheus/alertmanager/template"
+
+	"github.com/pgillich/micro-server/pkg/logger"
+
+	"github.com/pgillich/mimir-multitenant_alertmanager/configs"
+	api "github.com/pgillich/mimir-multitenant_alertmanager/pkg/api/notifyer"
+)
+
+var ErrTemplateFiles = errors.New("unable to load template files")
+
+// templateSet is the parsed template of the template sources and the notifiers, which render it.
+// It's swapped atomically, if the template files are changed.
+type templateSet struct {
+	template        *template.Template
+	notifiers       map[string][]notify.Notifier
+	digestNotifiers map[string][]notify.Notifier
+	stormNotifiers  map[string][]notify.Notifier
+	files           []string
+	checksum        string
+	loadedAt        time.Time
+}
+
+// templateFiles is the content of the template files, matched by the patterns
+type templateFiles struct {
+	files    []string
+	contents []string
+	checksum string
+}
+
+// readTemplateFiles reads the template files in the order of the patterns.
+// A pattern must match at least one file, so a mistyped pattern or a missing directory is an error,
+// at reload too, where the last good templates stay active.
+func readTemplateFiles(patterns []string) (templateFiles, error) {
+	files := templateFiles{}
+	hash := sha256.New()
+	for _, pattern := range patterns {
+		matches, err := filepath.Glob(pattern)
+		if err != nil {
+			return files, logger.Wrap(ErrTemplateFiles, fmt.Errorf("%s: %w", pattern, err))
+		}
+		if len(matches) == 0 {
+			return files, logger.Wrap(ErrTemplateFiles, fmt.Errorf("pattern %q matches no template file", pattern))
+		}
+		for _, file := range matches {
+			content, err := os.ReadFile(file) //nolint:gosec // file given by the config
+			if err != nil {
+				return files, logger.Wrap(ErrTemplateFiles, err)
+			}
+			files.files = append(files.files, file)
+			files.contents = append(files.contents, string(content))
+			fmt.Fprintf(hash, "%s\x00%d\x00", file, len(content))
+			hash.Write(content)
+		}
+	}
+	files.checksum = hex.EncodeToString(hash.Sum(nil))
+
+	return files, nil
+}
+
+// newTemplateSet parses the templates of the config and the template files, and creates the notifiers
+func (n *Notify) newTemplateSet(log *slog.Logger, files templateFiles, now time.Time) (*templateSet, error) {
+	tmpl, err := newTemplate(n.alertsConfig, n.config.ExternalURL, n.config.GrafanaURL)
+	if err != nil {
+		return nil, err
+	}
+	if err = templateFromContent(tmpl, n.config.Templates); err != nil {
+		return nil, err
+	}
+	for f, content := range files.contents {
+		if err = templateFromContent(tmpl, []string{content}); err != nil {
+			return nil, fmt.Errorf("%s: %w", files.files[f], err)
+		}
+	}
+
+	return &templateSet{
+		template:        tmpl,
+		notifiers:       newNotifiers(log, n.config, tmpl, n.dryRunLog, n.goKitLog),
+		digestNotifiers: newDigestNotifiers(log, n.config, tmpl, n.dryRunLog, n.goKitLog),
+		stormNotifiers:  newStormNotifiers(log, n.config, tmpl, n.dryRunLog, n.goKitLog),
+		files:           files.files,
+		checksum:        files.checksum,
+		loadedAt:        now,
+	}, nil
+}
+
+// loadTemplates loads the template set, the error is returned
+func (n *Notify) loadTemplates(log *slog.Logger, now time.Time) error {
+	files, err := readTemplateFiles(n.config.TemplateFiles)
+	if err != nil {
+		return err
+	}
+	templates, err := n.newTemplateSet(log, files, now)
+	if err != nil {
+		return err
+	}
+	n.templates.Store(templates)
+	n.templateStatus.Store(templates.status())
+
+	return nil
+}
+
+// reloadTemplates loads the template set, if the template files are changed.
+// The last good template set stays active, if the changed template files can't be loaded, the error is in the status.
+func (n *Notify) reloadTemplates(ctx context.Context, now time.Time) {
+	if len(n.config.TemplateFiles) == 0 {
+		return
+	}
+	_, log := logger.FromContext(ctx)
+	active := n.templates.Load()
+	files, err := readTemplateFiles(n.config.TemplateFiles)
+	if err == nil && files.checksum == active.checksum {
+		n.templateStatus.Store(active.status())
+
+		return
+	}
+	if status := n.templateStatus.Load(); err == nil && files.checksum == n.failedTemplates && status.Error != nil {
+		return
+	}
+
+	var templates *templateSet
+	if err == nil {
+		templates, err = n.newTemplateSet(log, files, now)
+	}
+	if err != nil {
+		log.Error("Unable to reload templates, the last good templates stay active", "files", files.files, logger.KeyError, err)
+		n.failedTemplates = files.checksum
+		status := active.status()
+		message := err.Error()
+		status.Error = &message
+		status.FailedAt = &now
+		n.templateStatus.Store(status)
+
+		return
+	}
+	log.Info("Templates reloaded", "files", templates.files, "checksum", templates.checksum)
+	n.templates.Store(templates)
+	n.templateStatus.Store(templates.status())
+}
+
+func (s *templateSet) status() *api.TemplateStatus {
+	files := s.files
+	if files == nil {
+		files = []string{}
+	}
+
+	return &api.TemplateStatus{Files: files, Checksum: s.checksum, LoadedAt: s.loadedAt}
+}
+
+// TemplateStatus returns the status of the template files
+func (n *Notify) TemplateStatus() api.TemplateStatus {
+	return *n.templateStatus.Load()
+}
+
+func (s *ApiServer) GetTemplateStatus(w http.ResponseWriter, r *http.Request) {
+	_, log := logger.FromContext(r.Context())
+	if err := api.GetTemplateStatus200JSONResponse(s.service.notify.TemplateStatus()).VisitGetTemplateStatusResponse(w); err != nil {
+		log.Error("Unable to render response", logger.KeyError, logger.Wrap(ErrRenderResponse, err))
+	}
+}
+
+// validateTemplateFiles parses the template files of the patterns, the patterns must match template files, see readTemplateFiles
+func validateTemplateFiles(tmpl *template.Template, patterns []string) configs.ConfigErrors {
+	configErrors := configs.ConfigErrors{}
+	for p, pattern := range patterns {
+		path := fmt.Sprintf("notifyer.templateFiles[%d]", p)
+		files, err := readTemplateFiles([]string{pattern})
+		if err != nil {
+			configErrors = append(configErrors, configs.ConfigError{Path: path, Message: err.Error()})
+		}
+		for f, content := range files.contents {
+			if err := templateFromContent(tmpl, []string{content}); err != nil {
+				configErrors = append(configErrors, configs.ConfigError{Path: path, Message: files.files[f] + ": " + err.Error()})
+			}
+		}
+	}
+
+	return configErrors
+}
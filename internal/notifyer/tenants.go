@@ -0,0 +1,287 @@
+package alertmanager
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"maps"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"text/template/parse"
+
+	am_config "github.com/prometheus/alertmanager/config"
+	"github.com/prometheus/alertmanager/pkg/labels"
+	prom_model "github.com/prometheus/common/model"
+	"gopkg.in/yaml.v2"
+
+	"github.com/pgillich/micro-server/pkg/logger"
+
+	"github.com/pgillich/mimir-multitenant_alertmanager/configs"
+)
+
+var ErrInvalidTenantConfig = errors.New("invalid tenant config")
+
+// TenantConfig is a loaded tenant notifyer config
+type TenantConfig struct {
+	configs.TenantNotifyerConfig `yaml:",inline"`
+	// Source is the file and the document index of the config, for the error messages
+	Source string `yaml:"-"`
+	// TemplateContents are the contents of the template files
+	TemplateContents []string `yaml:"-"`
+}
+
+// LoadTenantConfigs loads the tenant configs from a file or from the *.yaml and *.yml files of a directory.
+// A file may have more YAML documents. The email configs without smarthost get the SMTP settings of emailDefaults.
+func LoadTenantConfigs(path string, emailDefaults *am_config.EmailConfig) ([]TenantConfig, error) {
+	if path == "" {
+		return nil, nil
+	}
+	info, err := os.Stat(path)
+	if err != nil {
+		return nil, logger.Wrap(ErrInvalidTenantConfig, err)
+	}
+	files := []string{path}
+	if info.IsDir() {
+		files = []string{}
+		entries, err := os.ReadDir(path)
+		if err != nil {
+			return nil, logger.Wrap(ErrInvalidTenantConfig, err)
+		}
+		for _, entry := range entries {
+			if ext := filepath.Ext(entry.Name()); !entry.IsDir() && (ext == ".yaml" || ext == ".yml") {
+				files = append(files, filepath.Join(path, entry.Name()))
+			}
+		}
+	}
+
+	tenantConfigs := []TenantConfig{}
+	for _, file := range files {
+		fileConfigs, err := loadTenantConfigFile(file, info.IsDir(), emailDefaults)
+		if err != nil {
+			return nil, logger.Wrap(ErrInvalidTenantConfig, err)
+		}
+		tenantConfigs = append(tenantConfigs, fileConfigs...)
+	}
+
+	return tenantConfigs, nil
+}
+
+func loadTenantConfigFile(file string, inDir bool, emailDefaults *am_config.EmailConfig) ([]TenantConfig, error) {
+	content, err := os.ReadFile(file)
+	if err != nil {
+		return nil, err
+	}
+	tenantConfigs := []TenantConfig{}
+	decoder := yaml.NewDecoder(bytes.NewReader(content))
+	decoder.SetStrict(true)
+	for d := 0; ; d++ {
+		tenantConfig := TenantConfig{Source: fmt.Sprintf("%s[%d]", file, d)}
+		if err := decoder.Decode(&tenantConfig); errors.Is(err, io.EOF) {
+			break
+		} else if err != nil {
+			return nil, fmt.Errorf("%s: %w", tenantConfig.Source, err)
+		}
+		if fileTenant := strings.TrimSuffix(filepath.Base(file), filepath.Ext(file)); inDir && tenantConfig.Tenant == "" {
+			tenantConfig.Tenant = fileTenant
+		} else if inDir && tenantConfig.Tenant != fileTenant {
+			return nil, fmt.Errorf("%s: tenant %q differs from the file name", tenantConfig.Source, tenantConfig.Tenant)
+		}
+		for _, pattern := range tenantConfig.Templates {
+			if !filepath.IsAbs(pattern) {
+				pattern = filepath.Join(filepath.Dir(file), pattern)
+			}
+			templateFiles, err := filepath.Glob(pattern)
+			if err != nil {
+				return nil, fmt.Errorf("%s: %w", tenantConfig.Source, err)
+			}
+			for _, templateFile := range templateFiles {
+				templateContent, err := os.ReadFile(templateFile)
+				if err != nil {
+					return nil, fmt.Errorf("%s: %w", tenantConfig.Source, err)
+				}
+				tenantConfig.TemplateContents = append(tenantConfig.TemplateContents, string(templateContent))
+			}
+		}
+		for _, receiver := range tenantConfig.Receivers {
+			for _, emailConfig := range receiver.EmailConfigs {
+				setEmailDefaults(emailConfig, emailDefaults)
+			}
+		}
+		tenantConfigs = append(tenantConfigs, tenantConfig)
+	}
+
+	return tenantConfigs, nil
+}
+
+// setEmailDefaults sets the SMTP settings of the defaults, if the smarthost is not set, and the sender, if not set
+func setEmailDefaults(emailConfig *am_config.EmailConfig, defaults *am_config.EmailConfig) {
+	if emailConfig == nil || defaults == nil {
+		return
+	}
+	if emailConfig.Smarthost.Host == "" {
+		emailConfig.Smarthost = defaults.Smarthost
+		emailConfig.Hello = defaults.Hello
+		emailConfig.AuthUsername = defaults.AuthUsername
+		emailConfig.AuthPassword = defaults.AuthPassword
+		emailConfig.AuthSecret = defaults.AuthSecret
+		emailConfig.AuthIdentity = defaults.AuthIdentity
+		emailConfig.RequireTLS = defaults.RequireTLS
+		emailConfig.TLSConfig = defaults.TLSConfig
+	}
+	if emailConfig.From == "" {
+		emailConfig.From = defaults.From
+	}
+}
+
+// ValidateTenantConfigs checks the tenant configs, including the duplicated tenants, the template names
+// and the central child routes, which catch all alerts of a tenant before its tenant route
+func ValidateTenantConfigs(alertsConfig *configs.AlertsConfig, notifyerConfig *configs.NotifyerConfig, tenantConfigs []TenantConfig,
+) configs.ConfigErrors {
+	tenantLabel := tenantLabelOf(alertsConfig)
+	var tenants []string
+	if alertsConfig != nil {
+		tenants = alertsConfig.Tenants
+	}
+
+	configErrors := configs.ConfigErrors{}
+	sources := map[string]string{}
+	for _, tenantConfig := range tenantConfigs {
+		path := "notifyer.tenantConfigPath[" + tenantConfig.Source + "]"
+		if source, has := sources[tenantConfig.Tenant]; has && tenantConfig.Tenant != "" {
+			configErrors = append(configErrors, configs.ConfigError{
+				Path: path + ".tenant", Message: fmt.Sprintf("duplicated tenant %q, see %s", tenantConfig.Tenant, source),
+			})
+		}
+		sources[tenantConfig.Tenant] = tenantConfig.Source
+		configErrors = append(configErrors, tenantConfig.Validate(path, tenantLabel, tenants, notifyerConfig.TimeIntervals)...)
+		for _, r := range shadowingRoutes(notifyerConfig.Route, tenantLabel, tenantConfig.Tenant) {
+			configErrors = append(configErrors, configs.ConfigError{
+				Path: path + ".route", Message: fmt.Sprintf("shadowed by notifyer.route.routes[%d]", r),
+			})
+		}
+		for t, content := range tenantConfig.TemplateContents {
+			for _, message := range tenantTemplateErrors(tenantConfig.Tenant, content) {
+				configErrors = append(configErrors, configs.ConfigError{Path: fmt.Sprintf("%s.templates[%d]", path, t), Message: message})
+			}
+		}
+	}
+
+	return configErrors
+}
+
+// shadowingRoutes returns the indices of the central child routes without continue, which match all alerts of the tenant.
+// A central route, which matches a part of the tenant alerts only, takes precedence over the tenant route by design.
+func shadowingRoutes(route *am_config.Route, tenantLabel string, tenant string) []int {
+	if route == nil || tenant == "" {
+		return nil
+	}
+	tree, err := NewRouteTree(route)
+	if err != nil {
+		return nil
+	}
+	tenantLabels := prom_model.LabelSet{prom_model.LabelName(tenantLabel): prom_model.LabelValue(tenant)}
+	shadowing := []int{}
+	for r, child := range tree.Routes {
+		if !child.Continue && child.Matchers.Matches(tenantLabels) {
+			shadowing = append(shadowing, r)
+		}
+	}
+
+	return shadowing
+}
+
+// tenantTemplateErrors checks, if the template defines "<tenant>." prefixed templates only.
+// The functions are not checked here, the template is parsed with the other templates later.
+func tenantTemplateErrors(tenant string, content string) []string {
+	const topLevel = "."
+	tree := parse.New(topLevel)
+	tree.Mode = parse.SkipFuncCheck
+	treeSet := map[string]*parse.Tree{}
+	if _, err := tree.Parse(content, "", "", treeSet); err != nil {
+		return []string{err.Error()}
+	}
+	messages := []string{}
+	for _, name := range slices.Sorted(maps.Keys(treeSet)) {
+		if name != topLevel && !strings.HasPrefix(name, tenant+".") {
+			messages = append(messages, fmt.Sprintf("template %q must have %q prefix", name, tenant+"."))
+		}
+	}
+
+	return messages
+}
+
+// WithTenantConfigs returns the notifyer config with the loaded tenant configs.
+// The tenant routes are appended to the child routes of the root route, with an implicit tenant matcher.
+func WithTenantConfigs(alertsConfig *configs.AlertsConfig, notifyerConfig *configs.NotifyerConfig) (*configs.NotifyerConfig, error) {
+	tenantConfigs, err := LoadTenantConfigs(notifyerConfig.TenantConfigPath, notifyerConfig.TenantEmailDefaults)
+	if err != nil {
+		return nil, err
+	}
+	if err := ValidateTenantConfigs(alertsConfig, notifyerConfig, tenantConfigs).Err(); err != nil {
+		return nil, logger.Wrap(ErrInvalidTenantConfig, err)
+	}
+
+	return mergeTenantConfigs(notifyerConfig, tenantConfigs, tenantLabelOf(alertsConfig))
+}
+
+func tenantLabelOf(alertsConfig *configs.AlertsConfig) string {
+	if alertsConfig != nil && alertsConfig.TenantLabel != "" {
+		return alertsConfig.TenantLabel
+	}
+
+	return configs.DefaultTenantLabel
+}
+
+// mergeTenantConfigs adds the tenant routes, receivers and templates to a copy of the notifyer config.
+// The tenant routes are appended after the central child routes, so the route IDs of the central routes
+// (referred by the escalations and the flap detection) don't depend on the tenant configs.
+func mergeTenantConfigs(notifyerConfig *configs.NotifyerConfig, tenantConfigs []TenantConfig, tenantLabel string,
+) (*configs.NotifyerConfig, error) {
+	if len(tenantConfigs) == 0 || notifyerConfig.Route == nil {
+		return notifyerConfig, nil
+	}
+	merged := *notifyerConfig
+	root := *notifyerConfig.Route
+	root.Routes = slices.Clone(notifyerConfig.Route.Routes)
+	merged.Route = &root
+	merged.Receivers = slices.Clone(merged.Receivers)
+	merged.Templates = slices.Clone(merged.Templates)
+	for _, tenantConfig := range tenantConfigs {
+		matcher, err := labels.NewMatcher(labels.MatchEqual, tenantLabel, tenantConfig.Tenant)
+		if err != nil {
+			return nil, logger.Wrap(ErrInvalidTenantConfig, err)
+		}
+		route := tenantRoute(tenantConfig.Tenant, tenantConfig.Route)
+		route.Matchers = append(am_config.Matchers{matcher}, route.Matchers...)
+		root.Routes = append(root.Routes, route)
+		for _, receiver := range tenantConfig.Receivers {
+			receiver.Name = TenantReceiverName(tenantConfig.Tenant, receiver.Name)
+			merged.Receivers = append(merged.Receivers, receiver)
+		}
+		merged.Templates = append(merged.Templates, tenantConfig.TemplateContents...)
+	}
+
+	return &merged, nil
+}
+
+// tenantRoute returns a copy of the route tree with the tenant receiver names
+func tenantRoute(tenant string, route *am_config.Route) *am_config.Route {
+	copied := *route
+	if copied.Receiver != "" {
+		copied.Receiver = TenantReceiverName(tenant, copied.Receiver)
+	}
+	copied.Routes = make([]*am_config.Route, 0, len(route.Routes))
+	for _, child := range route.Routes {
+		copied.Routes = append(copied.Routes, tenantRoute(tenant, child))
+	}
+
+	return &copied
+}
+
+// TenantReceiverName is the name of a tenant receiver in the merged notifyer config
+func TenantReceiverName(tenant string, receiver string) string {
+	return tenant + "/" + receiver
+}
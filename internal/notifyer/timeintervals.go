@@ -0,0 +1,171 @@
+package alertmanager
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"maps"
+	"slices"
+	"strconv"
+	"time"
+
+	"github.com/prometheus/alertmanager/dispatch"
+	"github.com/prometheus/alertmanager/timeinterval"
+	am_types "github.com/prometheus/alertmanager/types"
+	"gopkg.in/yaml.v2"
+
+	"github.com/pgillich/micro-server/pkg/logger"
+
+	"github.com/pgillich/mimir-multitenant_alertmanager/configs"
+	api "github.com/pgillich/mimir-multitenant_alertmanager/pkg/api/notifyer"
+)
+
+var ErrInvalidTimeInterval = errors.New("invalid time interval")
+
+// NewTimeIntervals parses the time intervals of the config by the Alertmanager config format
+func NewTimeIntervals(timeIntervals []configs.TimeIntervalConfig) (map[string][]timeinterval.TimeInterval, error) {
+	intervals := map[string][]timeinterval.TimeInterval{}
+	for t, timeInterval := range timeIntervals {
+		for s, spec := range timeInterval.TimeIntervals {
+			interval, err := parseTimeInterval(spec)
+			if err != nil {
+				return nil, logger.Wrap(ErrInvalidTimeInterval,
+					fmt.Errorf("notifyer.timeIntervals[%d].timeIntervals[%d]: %w", t, s, err))
+			}
+			intervals[timeInterval.Name] = append(intervals[timeInterval.Name], interval)
+		}
+	}
+
+	return intervals, nil
+}
+
+// parseTimeInterval converts the spec to the Alertmanager config format and parses it
+func parseTimeInterval(spec configs.TimeIntervalSpec) (timeinterval.TimeInterval, error) {
+	amSpec := map[string]any{}
+	if len(spec.Times) > 0 {
+		times := make([]map[string]string, 0, len(spec.Times))
+		for _, timeRange := range spec.Times {
+			times = append(times, map[string]string{"start_time": timeRange.StartTime, "end_time": timeRange.EndTime})
+		}
+		amSpec["times"] = times
+	}
+	for key, values := range map[string][]string{
+		"weekdays": spec.Weekdays, "days_of_month": spec.DaysOfMonth, "months": spec.Months, "years": spec.Years,
+	} {
+		if len(values) > 0 {
+			amSpec[key] = values
+		}
+	}
+	if spec.Location != "" {
+		amSpec["location"] = spec.Location
+	}
+
+	interval := timeinterval.TimeInterval{}
+	content, err := yaml.Marshal(amSpec)
+	if err != nil {
+		return interval, err
+	}
+	err = yaml.UnmarshalStrict(content, &interval)
+
+	return interval, err
+}
+
+// routeMuted checks the time intervals of the route, like the Alertmanager TimeMuteStage and TimeActiveStage do
+func (n *Notify) routeMuted(route *dispatch.Route, now time.Time) (bool, error) {
+	if muted, err := n.intervener.Mutes(route.RouteOpts.MuteTimeIntervals, now); err != nil || muted {
+		return muted, err
+	}
+	if len(route.RouteOpts.ActiveTimeIntervals) == 0 {
+		return false, nil
+	}
+	active, err := n.intervener.Mutes(route.RouteOpts.ActiveTimeIntervals, now)
+
+	return !active, err
+}
+
+// heldGroup is the muted alerts of an alert group, which are notified at the end of the muted time interval
+type heldGroup struct {
+	group  alertGroup
+	alerts map[string]heldAlert
+}
+
+// heldAlert is the last state of a muted alert, firing is evaluated at the time of holding
+type heldAlert struct {
+	alert  *am_types.Alert
+	firing bool
+}
+
+// hold keeps the last state of the alerts of a muted or failed group.
+// A firing alert, which is resolved while it's held, is dropped, because it was not notified.
+func (n *Notify) hold(group *alertGroup, now time.Time) {
+	key := group.receiver + "/" + group.groupKey
+	held, has := n.held[key]
+	if !has {
+		held = &heldGroup{group: *group, alerts: map[string]heldAlert{}}
+		held.group.alerts = nil
+		n.held[key] = held
+	}
+	for _, alert := range group.alerts {
+		fingerprint := alert.Fingerprint().String()
+		firing := !alert.ResolvedAt(now)
+		if last, has := held.alerts[fingerprint]; has && last.firing && !firing {
+			delete(held.alerts, fingerprint)
+		} else {
+			held.alerts[fingerprint] = heldAlert{alert: alert, firing: firing}
+		}
+	}
+	if len(held.alerts) == 0 {
+		delete(n.held, key)
+	}
+}
+
+// releaseHeld notifies the held alerts of the groups, which are not muted anymore.
+// The firing alerts are notified only, if they are still firing in the snapshot.
+// The held groups are dropped after the notification, the failed groups are held again.
+func (n *Notify) releaseHeld(ctx context.Context, now time.Time, alerts map[string]api.GettableAlert) error {
+	_, log := logger.FromContext(ctx)
+	var errs []error
+	releasedKeys := []string{}
+	released := []*alertGroup{}
+	for _, key := range slices.Sorted(maps.Keys(n.held)) {
+		held := n.held[key]
+		if muted, err := n.routeMuted(held.group.route, now); err != nil {
+			errs = append(errs, err)
+
+			continue
+		} else if muted {
+			continue
+		}
+		releasedKeys = append(releasedKeys, key)
+
+		firing := []*am_types.Alert{}
+		resolved := []*am_types.Alert{}
+		for _, fingerprint := range slices.Sorted(maps.Keys(held.alerts)) {
+			alert := held.alerts[fingerprint].alert
+			if !held.alerts[fingerprint].firing {
+				resolved = append(resolved, alert)
+			} else if current, has := alerts[strconv.FormatUint(uint64(alert.Fingerprint()), 16)]; has &&
+				!ApiAlertToPromAlert(current).ResolvedAt(now) {
+				firing = append(firing, ApiAlertToPromAlert(current))
+			}
+		}
+		log.Info("Muted notifications released", "receiver", held.group.receiver, "groupKey", held.group.groupKey,
+			"firing", len(firing), "resolved", len(resolved))
+		for _, groupAlerts := range [][]*am_types.Alert{firing, resolved} {
+			if len(groupAlerts) > 0 {
+				group := held.group
+				group.alerts = groupAlerts
+				released = append(released, &group)
+			}
+		}
+	}
+	failed, notifyErrs := n.notifyGroups(ctx, now, released)
+	for _, key := range releasedKeys {
+		delete(n.held, key)
+	}
+	for _, group := range failed {
+		n.hold(group, now)
+	}
+
+	return errors.Join(append(errs, notifyErrs...)...)
+}
@@ -0,0 +1,131 @@
+package alertmanager
+
+import (
+	"fmt"
+	html_tmpl "html/template"
+	"maps"
+	"slices"
+	text_tmpl "text/template"
+	"time"
+
+	"github.com/pgillich/mimir-multitenant_alertmanager/configs"
+)
+
+// placeholderExternalURL is used for parsing the templates, if the external URL is invalid (reported separately)
+const placeholderExternalURL = "http://localhost"
+
+// ValidateConfig checks the notifyer config, including parsing the templates the same way as the notifier does.
+// The alerts config is not checked here.
+func ValidateConfig(alertsConfig *configs.AlertsConfig, notifyerConfig *configs.NotifyerConfig) configs.ConfigErrors {
+	if notifyerConfig == nil {
+		return notifyerConfig.Validate()
+	}
+	tenantErrors := configs.ConfigErrors{}
+	tenantConfigs, err := LoadTenantConfigs(notifyerConfig.TenantConfigPath, notifyerConfig.TenantEmailDefaults)
+	if err != nil {
+		tenantErrors = append(tenantErrors, configs.ConfigError{Path: "notifyer.tenantConfigPath", Message: err.Error()})
+	} else if tenantErrors = ValidateTenantConfigs(alertsConfig, notifyerConfig, tenantConfigs); len(tenantErrors) == 0 {
+		if merged, err := mergeTenantConfigs(notifyerConfig, tenantConfigs, tenantLabelOf(alertsConfig)); err == nil {
+			notifyerConfig = merged
+		}
+	}
+	configErrors := append(notifyerConfig.Validate(), tenantErrors...)
+
+	var textTemplate *text_tmpl.Template
+	var htmlTemplate *html_tmpl.Template
+	captureTemplates := func(text *text_tmpl.Template, html *html_tmpl.Template) {
+		textTemplate, htmlTemplate = text, html
+	}
+	tmpl, err := newTemplate(alertsConfig, notifyerConfig.ExternalURL, notifyerConfig.GrafanaURL, captureTemplates)
+	if err != nil {
+		if tmpl, err = newTemplate(alertsConfig, placeholderExternalURL, notifyerConfig.GrafanaURL, captureTemplates); err != nil {
+			return append(configErrors, configs.ConfigError{Path: "notifyer.templates", Message: err.Error()})
+		}
+	}
+	for t, content := range notifyerConfig.Templates {
+		if err := templateFromContent(tmpl, []string{content}); err != nil {
+			configErrors = append(configErrors, configs.ConfigError{Path: fmt.Sprintf("notifyer.templates[%d]", t), Message: err.Error()})
+		}
+	}
+	configErrors = append(configErrors, validateTemplateFiles(tmpl, notifyerConfig.TemplateFiles)...)
+
+	for r, receiver := range notifyerConfig.Receivers {
+		for e, emailConfig := range receiver.EmailConfigs {
+			if emailConfig == nil {
+				continue
+			}
+			path := fmt.Sprintf("notifyer.receivers[%d].emailConfigs[%d]", r, e)
+			for _, name := range slices.Sorted(maps.Keys(emailConfig.Headers)) {
+				if _, err := textTemplate.New(name).Parse(emailConfig.Headers[name]); err != nil {
+					configErrors = append(configErrors, configs.ConfigError{Path: path + ".headers." + name, Message: err.Error()})
+				}
+			}
+			if _, err := textTemplate.New(TemplateNameText).Parse(emailConfig.Text); err != nil {
+				configErrors = append(configErrors, configs.ConfigError{Path: path + ".text", Message: err.Error()})
+			}
+			if _, err := htmlTemplate.New(TemplateNameHtml).Parse(emailConfig.HTML); err != nil {
+				configErrors = append(configErrors, configs.ConfigError{Path: path + ".html", Message: err.Error()})
+			}
+		}
+	}
+
+	for t, timeInterval := range notifyerConfig.TimeIntervals {
+		for i, spec := range timeInterval.TimeIntervals {
+			if _, err := parseTimeInterval(spec); err != nil {
+				configErrors = append(configErrors, configs.ConfigError{
+					Path:    fmt.Sprintf("notifyer.timeIntervals[%d].timeIntervals[%d]", t, i),
+					Message: err.Error(),
+				})
+			}
+		}
+	}
+
+	for d, digest := range notifyerConfig.Digests {
+		path := fmt.Sprintf("notifyer.digests[%d]", d)
+		if _, err := time.LoadLocation(digest.Location); err == nil && digest.Schedule != "" { // location is checked by Validate
+			if _, err := parseSchedule(digest.Schedule, digest.Location); err != nil {
+				configErrors = append(configErrors, configs.ConfigError{Path: path + ".schedule", Message: err.Error()})
+			}
+		}
+		if digest.Template != "" && htmlTemplate.Lookup(digest.Template) == nil {
+			configErrors = append(configErrors, configs.ConfigError{
+				Path: path + ".template", Message: fmt.Sprintf("undefined template %q", digest.Template),
+			})
+		}
+		if _, err := textTemplate.New("subject").Parse(digest.Subject); err != nil {
+			configErrors = append(configErrors, configs.ConfigError{Path: path + ".subject", Message: err.Error()})
+		}
+	}
+
+	for r, recipients := range notifyerConfig.Recipients {
+		path := fmt.Sprintf("notifyer.recipients[%d]", r)
+		if _, err := textTemplate.New("to").Parse(recipients.To); err != nil {
+			configErrors = append(configErrors, configs.ConfigError{Path: path + ".to", Message: err.Error()})
+		}
+		if _, err := textTemplate.New("cc").Parse(recipients.Cc); err != nil {
+			configErrors = append(configErrors, configs.ConfigError{Path: path + ".cc", Message: err.Error()})
+		}
+	}
+
+	if tree, err := NewRouteTree(notifyerConfig.Route); err == nil {
+		routeIDs := routeIDs(tree)
+		for r, routeFlapDetection := range notifyerConfig.RouteFlapDetection {
+			if routeFlapDetection.RouteID != "" && !slices.Contains(routeIDs, routeFlapDetection.RouteID) {
+				configErrors = append(configErrors, configs.ConfigError{
+					Path:    fmt.Sprintf("notifyer.routeFlapDetection[%d].routeId", r),
+					Message: fmt.Sprintf("unknown route %q", routeFlapDetection.RouteID),
+				})
+			}
+		}
+		for e, escalation := range notifyerConfig.Escalations {
+			if escalation.RouteID != "" && !slices.Contains(routeIDs, escalation.RouteID) {
+				configErrors = append(configErrors, configs.ConfigError{
+					Path:    fmt.Sprintf("notifyer.escalations[%d].routeId", e),
+					Message: fmt.Sprintf("unknown route %q", escalation.RouteID),
+				})
+			}
+		}
+	}
+
+	return configErrors
+}
@@ -0,0 +1,92 @@
+package webui
+
+import (
+	"context"
+	"embed"
+	"encoding/json"
+	"errors"
+	"io/fs"
+	"net/http"
+	"path"
+
+	"github.com/go-chi/chi/v5"
+	"go.opentelemetry.io/otel/trace"
+
+	srv_configs "github.com/pgillich/micro-server/pkg/configs"
+	"github.com/pgillich/micro-server/pkg/logger"
+	"github.com/pgillich/micro-server/pkg/model"
+	"github.com/pgillich/micro-server/pkg/server"
+
+	"github.com/pgillich/mimir-multitenant_alertmanager/configs"
+)
+
+var (
+	ErrUnableToPrepareService = errors.New("unable to prepare service")
+
+	//go:embed static
+	staticFiles embed.FS
+)
+
+// Settings is sent to the UI as config.json
+type Settings struct {
+	ApiUrl      string `json:"apiUrl"`
+	TenantLabel string `json:"tenantLabel"`
+}
+
+// HttpService serves the embedded web UI of the multi-tenant alerts.
+// The UI reads the API of the multitenant-alertmanager service, so both services should run.
+type HttpService struct{}
+
+func newHttpService() model.HttpServicer {
+	return &HttpService{}
+}
+
+func init() {
+	server.RegisterHttpService(newHttpService)
+}
+
+func (s *HttpService) Name() string {
+	return configs.ServiceNameWebUI
+}
+
+func (s *HttpService) Prepare(ctx context.Context, serverConfiger srv_configs.ServerConfiger, testConfig srv_configs.TestConfiger,
+	httpRouter chi.Router, tr trace.Tracer,
+) error {
+	serverConfig, is := serverConfiger.(*configs.ServerConfig)
+	if !is {
+		return srv_configs.ErrFatalServerConfig
+	}
+	settings := Settings{
+		ApiUrl:      path.Join("/", configs.ServiceNameAlertmanager, "/api/v2"),
+		TenantLabel: configs.DefaultTenantLabel,
+	}
+	if serverConfig.Alerts != nil && serverConfig.Alerts.TenantLabel != "" {
+		settings.TenantLabel = serverConfig.Alerts.TenantLabel
+	}
+
+	staticFS, err := fs.Sub(staticFiles, "static")
+	if err != nil {
+		return logger.Wrap(ErrUnableToPrepareService, err)
+	}
+	basePath := path.Join("/", configs.ServiceNameWebUI)
+
+	httpRouter.Get(basePath+"/config.json", func(w http.ResponseWriter, r *http.Request) {
+		_, log := logger.FromContext(r.Context())
+		w.Header().Set("Content-Type", "application/json")
+		if err := json.NewEncoder(w).Encode(settings); err != nil {
+			log.Error("Unable to render response", logger.KeyError, err)
+		}
+	})
+	httpRouter.Get(basePath, http.RedirectHandler(basePath+"/", http.StatusMovedPermanently).ServeHTTP)
+	httpRouter.Handle(basePath+"/*", http.StripPrefix(basePath, http.FileServer(http.FS(staticFS))))
+
+	return nil
+}
+
+func (s *HttpService) Start(ctx context.Context) error {
+	return nil
+}
+
+func (s *HttpService) Stop(ctx context.Context) error {
+	return nil
+}
@@ -0,0 +1,303 @@
+"use strict";
+
+// Settings are served by the ui service (config.json), defaults are used if it's not available.
+let settings = {
+  apiUrl: "../multitenant-alertmanager/api/v2",
+  tenantLabel: "tenant",
+};
+
+let alerts = [];
+let groups = [];
+let silences = [];
+
+async function loadSettings() {
+  try {
+    const resp = await fetch("config.json");
+    if (resp.ok) {
+      settings = Object.assign(settings, await resp.json());
+    }
+  } catch (err) {
+    console.warn("Unable to load config.json", err);
+  }
+}
+
+async function getJSON(path) {
+  const base = new URL(settings.apiUrl.replace(/\/?$/, "/"), window.location.href);
+  const resp = await fetch(new URL(path, base));
+  if (!resp.ok) {
+    throw new Error(path + ": " + resp.status + " " + (await resp.text()));
+  }
+  return resp.json();
+}
+
+function setStatus(text, isError) {
+  const status = document.getElementById("status");
+  status.textContent = text;
+  status.className = isError ? "error" : "";
+}
+
+function el(tag, text, className) {
+  const e = document.createElement(tag);
+  if (text !== undefined && text !== null) {
+    e.textContent = text;
+  }
+  if (className) {
+    e.className = className;
+  }
+  return e;
+}
+
+// httpURL returns the absolute URL if it's http or https, otherwise empty (no javascript: or data: links).
+function httpURL(value) {
+  try {
+    const url = new URL(value, window.location.href);
+    if (url.protocol === "http:" || url.protocol === "https:") {
+      return url.href;
+    }
+  } catch (err) {
+    console.warn("Invalid URL", value, err);
+  }
+  return "";
+}
+
+function labelSpans(labels) {
+  const td = el("td");
+  Object.keys(labels || {}).sort().forEach((name) => {
+    td.appendChild(el("span", name + "=" + labels[name], "label"));
+  });
+  return td;
+}
+
+function formatTime(value) {
+  if (!value) {
+    return "";
+  }
+  const d = new Date(value);
+  return isNaN(d) ? value : d.toLocaleString();
+}
+
+function receiverNames(alert) {
+  return (alert.receivers || []).map((r) => r.name);
+}
+
+function alertLink(alert) {
+  const a = el("a", alert.labels.alertname || alert.fingerprint);
+  a.href = "alert.html?fingerprint=" + encodeURIComponent(alert.fingerprint);
+  return a;
+}
+
+function fillSelect(id, values) {
+  const select = document.getElementById(id);
+  const selected = select.value;
+  while (select.options.length > 1) {
+    select.remove(1);
+  }
+  Array.from(new Set(values)).filter((v) => v).sort().forEach((v) => {
+    const option = el("option", v);
+    option.value = v;
+    select.appendChild(option);
+  });
+  select.value = selected;
+}
+
+function filterValues() {
+  return {
+    tenant: document.getElementById("filter-tenant").value,
+    severity: document.getElementById("filter-severity").value,
+    receiver: document.getElementById("filter-receiver").value,
+  };
+}
+
+function matchAlert(alert, f) {
+  if (f.tenant && alert.labels[settings.tenantLabel] !== f.tenant) {
+    return false;
+  }
+  if (f.severity && alert.labels.severity !== f.severity) {
+    return false;
+  }
+  if (f.receiver && !receiverNames(alert).includes(f.receiver)) {
+    return false;
+  }
+  return true;
+}
+
+function renderAlerts() {
+  const f = filterValues();
+  const body = document.getElementById("alerts-body");
+  body.replaceChildren();
+  alerts.filter((a) => matchAlert(a, f)).forEach((alert) => {
+    const tr = el("tr");
+    tr.appendChild(el("td", alert.labels[settings.tenantLabel]));
+    const name = el("td");
+    name.appendChild(alertLink(alert));
+    tr.appendChild(name);
+    tr.appendChild(el("td", alert.labels.severity, "severity-" + alert.labels.severity));
+    tr.appendChild(el("td", alert.status.state, "state-" + alert.status.state));
+    tr.appendChild(el("td", formatTime(alert.startsAt)));
+    tr.appendChild(el("td", (alert.annotations || {}).summary));
+    tr.appendChild(el("td", receiverNames(alert).join(", ")));
+    body.appendChild(tr);
+  });
+}
+
+function renderGroups() {
+  const f = filterValues();
+  const body = document.getElementById("groups-body");
+  body.replaceChildren();
+  groups.forEach((group) => {
+    if (f.receiver && group.receiver.name !== f.receiver) {
+      return;
+    }
+    const groupAlerts = group.alerts.filter((a) => matchAlert(a, Object.assign({}, f, { receiver: "" })));
+    if (groupAlerts.length === 0) {
+      return;
+    }
+    const div = el("div", null, "group");
+    const title = el("h3", group.receiver.name + " ");
+    const labels = labelSpans(group.labels);
+    while (labels.firstChild) {
+      title.appendChild(labels.firstChild);
+    }
+    div.appendChild(title);
+    const ul = el("ul");
+    groupAlerts.forEach((alert) => {
+      const li = el("li");
+      li.appendChild(alertLink(alert));
+      li.appendChild(el("span", " " + alert.status.state, "state-" + alert.status.state));
+      li.appendChild(el("span", " " + ((alert.annotations || {}).summary || "")));
+      ul.appendChild(li);
+    });
+    div.appendChild(ul);
+    body.appendChild(div);
+  });
+}
+
+function matchersText(matchers) {
+  return (matchers || []).map((m) => {
+    let op = m.isEqual === false ? "!=" : "=";
+    if (m.isRegex) {
+      op = m.isEqual === false ? "!~" : "=~";
+    }
+    return m.name + op + '"' + m.value + '"';
+  });
+}
+
+function renderSilences() {
+  const f = filterValues();
+  const body = document.getElementById("silences-body");
+  body.replaceChildren();
+  silences.forEach((silence) => {
+    if (f.tenant && !(silence.matchers || []).some((m) => m.name === settings.tenantLabel && m.value === f.tenant)) {
+      return;
+    }
+    const tr = el("tr");
+    const td = el("td");
+    matchersText(silence.matchers).forEach((m) => td.appendChild(el("span", m, "label")));
+    tr.appendChild(td);
+    tr.appendChild(el("td", silence.status.state, "state-" + silence.status.state));
+    tr.appendChild(el("td", formatTime(silence.startsAt)));
+    tr.appendChild(el("td", formatTime(silence.endsAt)));
+    tr.appendChild(el("td", silence.createdBy));
+    tr.appendChild(el("td", silence.comment));
+    body.appendChild(tr);
+  });
+}
+
+function render() {
+  renderAlerts();
+  renderGroups();
+  renderSilences();
+}
+
+function showView() {
+  const view = (window.location.hash || "#alerts").substring(1);
+  document.querySelectorAll(".view").forEach((v) => v.classList.toggle("active", v.id === "view-" + view));
+  document.querySelectorAll("nav a").forEach((a) => a.classList.toggle("active", a.dataset.view === view));
+}
+
+async function refresh() {
+  setStatus("Loading...");
+  try {
+    [alerts, groups, silences] = await Promise.all([getJSON("alerts"), getJSON("alerts/groups"), getJSON("silences")]);
+    fillSelect("filter-tenant", alerts.map((a) => a.labels[settings.tenantLabel]));
+    fillSelect("filter-severity", alerts.map((a) => a.labels.severity));
+    fillSelect("filter-receiver", alerts.flatMap(receiverNames).concat(groups.map((g) => g.receiver.name)));
+    render();
+    setStatus("Updated at " + new Date().toLocaleTimeString());
+  } catch (err) {
+    setStatus(err.message, true);
+  }
+}
+
+async function listPage() {
+  await loadSettings();
+  ["filter-tenant", "filter-severity", "filter-receiver"].forEach((id) => {
+    document.getElementById(id).addEventListener("change", render);
+  });
+  document.getElementById("refresh").addEventListener("click", refresh);
+  window.addEventListener("hashchange", showView);
+  showView();
+  await refresh();
+}
+
+async function alertPage() {
+  await loadSettings();
+  const params = new URLSearchParams(window.location.search);
+  const fingerprint = params.get("fingerprint");
+  if (!fingerprint) {
+    setStatus("Missing fingerprint", true);
+    return;
+  }
+  let path = "alerts/" + encodeURIComponent(fingerprint);
+  if (params.get("tenant")) {
+    path += "?tenant=" + encodeURIComponent(params.get("tenant"));
+  }
+  try {
+    const tenantAlert = await getJSON(path);
+    const alert = tenantAlert.alert;
+    document.title = alert.labels.alertname || alert.fingerprint;
+    document.getElementById("alert-title").textContent = document.title;
+
+    const fields = document.getElementById("alert-fields");
+    [
+      ["Tenant", tenantAlert.tenant],
+      ["State", alert.status.state],
+      ["Started", formatTime(alert.startsAt)],
+      ["Updated", formatTime(alert.updatedAt)],
+      ["Ends", formatTime(alert.endsAt)],
+      ["Receivers", receiverNames(alert).join(", ")],
+      ["Silenced by", (alert.status.silencedBy || []).join(", ")],
+      ["Inhibited by", (alert.status.inhibitedBy || []).join(", ")],
+      ["Fingerprint", tenantAlert.fingerprint],
+      ["Upstream fingerprint", tenantAlert.upstreamFingerprint],
+    ].forEach(([name, value]) => {
+      fields.appendChild(el("dt", name));
+      fields.appendChild(el("dd", value));
+    });
+    if (alert.generatorURL) {
+      const dd = el("dd");
+      const url = httpURL(alert.generatorURL);
+      if (url) {
+        const a = el("a", alert.generatorURL);
+        a.href = url;
+        dd.appendChild(a);
+      } else {
+        dd.textContent = alert.generatorURL;
+      }
+      fields.appendChild(el("dt", "Source"));
+      fields.appendChild(dd);
+    }
+
+    [["alert-labels", alert.labels], ["alert-annotations", alert.annotations]].forEach(([id, values]) => {
+      const body = document.getElementById(id);
+      Object.keys(values || {}).sort().forEach((name) => {
+        const tr = el("tr");
+        tr.appendChild(el("th", name));
+        tr.appendChild(el("td", values[name]));
+        body.appendChild(tr);
+      });
+    });
+  } catch (err) {
+    setStatus(err.message, true);
+  }
+}
@@ -26,15 +26,16 @@ import (
 	"log/slog"
 	"os"
 
-	"github.com/pgillich/micro-server/pkg/cmd"
 	"github.com/pgillich/micro-server/pkg/logger"
 
 	"github.com/pgillich/mimir-multitenant_alertmanager/configs"
 	"github.com/pgillich/mimir-multitenant_alertmanager/internal/buildinfo"
+	"github.com/pgillich/mimir-multitenant_alertmanager/internal/cmd"
 
 	// force to run init() functions
 	_ "github.com/pgillich/mimir-multitenant_alertmanager/internal/alertmanager"
 	_ "github.com/pgillich/mimir-multitenant_alertmanager/internal/notifyer"
+	_ "github.com/pgillich/mimir-multitenant_alertmanager/internal/webui"
 )
 
 func main() {
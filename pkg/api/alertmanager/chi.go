@@ -4,6 +4,7 @@
 package api
 
 import (
+	"bytes"
 	"context"
 	"encoding/json"
 	"fmt"
@@ -25,6 +26,12 @@ const (
 	AlertStatusStateUnprocessed AlertStatusState = "unprocessed"
 )
 
+// Defines values for ConfigFindingSeverity.
+const (
+	ConfigFindingError   ConfigFindingSeverity = "error"
+	ConfigFindingWarning ConfigFindingSeverity = "warning"
+)
+
 // Defines values for SilenceStatusState.
 const (
 	SilenceStatusStateActive  SilenceStatusState = "active"
@@ -32,6 +39,50 @@ const (
 	SilenceStatusStatePending SilenceStatusState = "pending"
 )
 
+// Defines values for GetActionParamsAction.
+const (
+	GetActionParamsActionAck     GetActionParamsAction = "ack"
+	GetActionParamsActionSilence GetActionParamsAction = "silence"
+	GetActionParamsActionView    GetActionParamsAction = "view"
+)
+
+// Defines values for PostActionParamsAction.
+const (
+	PostActionParamsActionAck     PostActionParamsAction = "ack"
+	PostActionParamsActionSilence PostActionParamsAction = "silence"
+)
+
+// Defines values for GetRulesParamsType.
+const (
+	GetRulesParamsTypeAlert  GetRulesParamsType = "alert"
+	GetRulesParamsTypeRecord GetRulesParamsType = "record"
+)
+
+// Receiver defines model for Receiver.
+type Receiver struct {
+	Name string `json:"name"`
+}
+
+// Ack defines model for ack.
+type Ack struct {
+	AckedAt     time.Time  `json:"ackedAt"`
+	Comment     string     `json:"comment"`
+	ExpiresAt   *time.Time `json:"expiresAt,omitempty"`
+	Fingerprint string     `json:"fingerprint"`
+	User        string     `json:"user"`
+}
+
+// ActionResult defines model for actionResult.
+type ActionResult struct {
+	Action      string `json:"action"`
+	Fingerprint string `json:"fingerprint"`
+	Message     string `json:"message"`
+
+	// SilenceID ID of the created or existing silence (silence action)
+	SilenceID *string `json:"silenceID,omitempty"`
+	Tenant    string  `json:"tenant"`
+}
+
 // Alert defines model for alert.
 type Alert struct {
 	GeneratorURL *string  `json:"generatorURL,omitempty"`
@@ -59,6 +110,18 @@ type AlertStatus struct {
 // AlertStatusState defines model for AlertStatus.State.
 type AlertStatusState string
 
+// ConfigFinding defines model for configFinding.
+type ConfigFinding struct {
+	Message  string                `json:"message"`
+	Severity ConfigFindingSeverity `json:"severity"`
+}
+
+// ConfigFindingSeverity defines model for ConfigFinding.Severity.
+type ConfigFindingSeverity string
+
+// ConfigFindings defines model for configFindings.
+type ConfigFindings = []ConfigFinding
+
 // GettableAlert defines model for gettableAlert.
 type GettableAlert struct {
 	Annotations  LabelSet    `json:"annotations"`
@@ -104,9 +167,30 @@ type Matcher struct {
 // Matchers defines model for matchers.
 type Matchers = []Matcher
 
-// Receiver defines model for receiver.
-type Receiver struct {
-	Name string `json:"name"`
+// PostableAck defines model for postableAck.
+type PostableAck struct {
+	Comment *string `json:"comment,omitempty"`
+
+	// ExpiresAt The acknowledgement expires at this time, never expires if missing
+	ExpiresAt *time.Time `json:"expiresAt,omitempty"`
+	User      string     `json:"user"`
+}
+
+// PostableSilence defines model for postableSilence.
+type PostableSilence struct {
+	Comment   string    `json:"comment"`
+	CreatedBy string    `json:"createdBy"`
+	EndsAt    time.Time `json:"endsAt"`
+	Id        *string   `json:"id,omitempty"`
+	Matchers  Matchers  `json:"matchers"`
+	StartsAt  time.Time `json:"startsAt"`
+}
+
+// PostableTenantConfig defines model for postableTenantConfig.
+type PostableTenantConfig struct {
+	AlertmanagerConfig string    `json:"alertmanagerConfig"`
+	TemplateFiles      *LabelSet `json:"templateFiles,omitempty"`
+	Tenants            []string  `json:"tenants"`
 }
 
 // Silence defines model for silence.
@@ -126,6 +210,107 @@ type SilenceStatus struct {
 // SilenceStatusState defines model for SilenceStatus.State.
 type SilenceStatusState string
 
+// TenantAlert defines model for tenantAlert.
+type TenantAlert struct {
+	Alert               GettableAlert `json:"alert"`
+	Fingerprint         string        `json:"fingerprint"`
+	Tenant              string        `json:"tenant"`
+	UpstreamFingerprint string        `json:"upstreamFingerprint"`
+}
+
+// TenantConfig defines model for tenantConfig.
+type TenantConfig struct {
+	// AlertmanagerConfig The normalized configuration with masked secrets, empty if the configuration is invalid
+	AlertmanagerConfig *string `json:"alertmanagerConfig,omitempty"`
+
+	// Diff Unified diff from the baseline configuration, empty if there is no difference or no baseline
+	Diff     *string        `json:"diff,omitempty"`
+	Findings ConfigFindings `json:"findings"`
+
+	// Found The tenant has Alertmanager configuration
+	Found         bool     `json:"found"`
+	TemplateFiles []string `json:"templateFiles"`
+	Tenant        string   `json:"tenant"`
+}
+
+// TenantConfigPushResult defines model for tenantConfigPushResult.
+type TenantConfigPushResult struct {
+	Error  *string `json:"error,omitempty"`
+	Pushed bool    `json:"pushed"`
+	Tenant string  `json:"tenant"`
+}
+
+// TenantConfigPushResults defines model for tenantConfigPushResults.
+type TenantConfigPushResults = []TenantConfigPushResult
+
+// TenantConfigs defines model for tenantConfigs.
+type TenantConfigs = []TenantConfig
+
+// TenantRule defines model for tenantRule.
+type TenantRule struct {
+	Alerts      *GettableAlerts `json:"alerts,omitempty"`
+	Annotations *LabelSet       `json:"annotations,omitempty"`
+	Health      string          `json:"health"`
+	Labels      LabelSet        `json:"labels"`
+	LastError   *string         `json:"lastError,omitempty"`
+	Name        string          `json:"name"`
+	Query       string          `json:"query"`
+	State       *string         `json:"state,omitempty"`
+	Type        string          `json:"type"`
+}
+
+// TenantRuleGroup defines model for tenantRuleGroup.
+type TenantRuleGroup struct {
+	File     string       `json:"file"`
+	Interval *float32     `json:"interval,omitempty"`
+	Name     string       `json:"name"`
+	Rules    []TenantRule `json:"rules"`
+	Tenant   string       `json:"tenant"`
+}
+
+// TenantRuleGroups defines model for tenantRuleGroups.
+type TenantRuleGroups = []TenantRuleGroup
+
+// GetActionParams defines parameters for GetAction.
+type GetActionParams struct {
+	Tenant string `form:"tenant" json:"tenant"`
+
+	// Fingerprint Multi-tenant fingerprint of the alert
+	Fingerprint string `form:"fingerprint" json:"fingerprint"`
+
+	// Duration Duration of the silence, for example 2h
+	Duration *string `form:"duration,omitempty" json:"duration,omitempty"`
+
+	// Expires Expiration of the link, Unix time in seconds
+	Expires int64 `form:"expires" json:"expires"`
+
+	// Signature HMAC-SHA256 signature of the link
+	Signature string `form:"signature" json:"signature"`
+}
+
+// GetActionParamsAction defines parameters for GetAction.
+type GetActionParamsAction string
+
+// PostActionParams defines parameters for PostAction.
+type PostActionParams struct {
+	Tenant string `form:"tenant" json:"tenant"`
+
+	// Fingerprint Multi-tenant fingerprint of the alert
+	Fingerprint string `form:"fingerprint" json:"fingerprint"`
+
+	// Duration Duration of the silence, for example 2h
+	Duration *string `form:"duration,omitempty" json:"duration,omitempty"`
+
+	// Expires Expiration of the link, Unix time in seconds
+	Expires int64 `form:"expires" json:"expires"`
+
+	// Signature HMAC-SHA256 signature of the link
+	Signature string `form:"signature" json:"signature"`
+}
+
+// PostActionParamsAction defines parameters for PostAction.
+type PostActionParamsAction string
+
 // GetAlertsParams defines parameters for GetAlerts.
 type GetAlertsParams struct {
 	// Active Show active alerts
@@ -168,12 +353,45 @@ type GetAlertGroupsParams struct {
 	Receiver *string `form:"receiver,omitempty" json:"receiver,omitempty"`
 }
 
+// GetTenantAlertParams defines parameters for GetTenantAlert.
+type GetTenantAlertParams struct {
+	// Tenant Tenant of the alert, enables lookup by upstream fingerprint
+	Tenant *string `form:"tenant,omitempty" json:"tenant,omitempty"`
+}
+
+// GetTenantConfigsParams defines parameters for GetTenantConfigs.
+type GetTenantConfigsParams struct {
+	// Tenant Tenants to get, all tenants are returned by default
+	Tenant *[]string `form:"tenant,omitempty" json:"tenant,omitempty"`
+}
+
+// GetRulesParams defines parameters for GetRules.
+type GetRulesParams struct {
+	// Type Return only the alerting rules (alert) or the recording rules (record)
+	Type *GetRulesParamsType `form:"type,omitempty" json:"type,omitempty"`
+
+	// Alerts Join the active alerts to the alerting rules by alertname and labels
+	Alerts *bool `form:"alerts,omitempty" json:"alerts,omitempty"`
+}
+
+// GetRulesParamsType defines parameters for GetRules.
+type GetRulesParamsType string
+
 // GetSilencesParams defines parameters for GetSilences.
 type GetSilencesParams struct {
 	// Filter A list of matchers to filter silences by
 	Filter *[]string `form:"filter,omitempty" json:"filter,omitempty"`
 }
 
+// PostAckJSONRequestBody defines body for PostAck for application/json ContentType.
+type PostAckJSONRequestBody = PostableAck
+
+// PostTenantConfigsJSONRequestBody defines body for PostTenantConfigs for application/json ContentType.
+type PostTenantConfigsJSONRequestBody = PostableTenantConfig
+
+// PostSilencesJSONRequestBody defines body for PostSilences for application/json ContentType.
+type PostSilencesJSONRequestBody = PostableSilence
+
 // RequestEditorFn  is the function signature for the RequestEditor callback function
 type RequestEditorFn func(ctx context.Context, req *http.Request) error
 
@@ -247,14 +465,86 @@ func WithRequestEditorFn(fn RequestEditorFn) ClientOption {
 
 // The interface specification for the client above.
 type ClientInterface interface {
+	// GetAcks request
+	GetAcks(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetAction request
+	GetAction(ctx context.Context, action GetActionParamsAction, params *GetActionParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PostAction request
+	PostAction(ctx context.Context, action PostActionParamsAction, params *PostActionParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
 	// GetAlerts request
 	GetAlerts(ctx context.Context, params *GetAlertsParams, reqEditors ...RequestEditorFn) (*http.Response, error)
 
 	// GetAlertGroups request
 	GetAlertGroups(ctx context.Context, params *GetAlertGroupsParams, reqEditors ...RequestEditorFn) (*http.Response, error)
 
+	// GetTenantAlert request
+	GetTenantAlert(ctx context.Context, fingerprint string, params *GetTenantAlertParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// DeleteAck request
+	DeleteAck(ctx context.Context, fingerprint string, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PostAckWithBody request with any body
+	PostAckWithBody(ctx context.Context, fingerprint string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PostAck(ctx context.Context, fingerprint string, body PostAckJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetTenantConfigs request
+	GetTenantConfigs(ctx context.Context, params *GetTenantConfigsParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PostTenantConfigsWithBody request with any body
+	PostTenantConfigsWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PostTenantConfigs(ctx context.Context, body PostTenantConfigsJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetRules request
+	GetRules(ctx context.Context, params *GetRulesParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
 	// GetSilences request
 	GetSilences(ctx context.Context, params *GetSilencesParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PostSilencesWithBody request with any body
+	PostSilencesWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PostSilences(ctx context.Context, body PostSilencesJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+}
+
+func (c *Client) GetAcks(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetAcksRequest(c.Server)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetAction(ctx context.Context, action GetActionParamsAction, params *GetActionParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetActionRequest(c.Server, action, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostAction(ctx context.Context, action PostActionParamsAction, params *PostActionParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostActionRequest(c.Server, action, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
 func (c *Client) GetAlerts(ctx context.Context, params *GetAlertsParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
@@ -281,6 +571,102 @@ func (c *Client) GetAlertGroups(ctx context.Context, params *GetAlertGroupsParam
 	return c.Client.Do(req)
 }
 
+func (c *Client) GetTenantAlert(ctx context.Context, fingerprint string, params *GetTenantAlertParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetTenantAlertRequest(c.Server, fingerprint, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) DeleteAck(ctx context.Context, fingerprint string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeleteAckRequest(c.Server, fingerprint)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostAckWithBody(ctx context.Context, fingerprint string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostAckRequestWithBody(c.Server, fingerprint, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostAck(ctx context.Context, fingerprint string, body PostAckJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostAckRequest(c.Server, fingerprint, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetTenantConfigs(ctx context.Context, params *GetTenantConfigsParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetTenantConfigsRequest(c.Server, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostTenantConfigsWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostTenantConfigsRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostTenantConfigs(ctx context.Context, body PostTenantConfigsJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostTenantConfigsRequest(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetRules(ctx context.Context, params *GetRulesParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetRulesRequest(c.Server, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
 func (c *Client) GetSilences(ctx context.Context, params *GetSilencesParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
 	req, err := NewGetSilencesRequest(c.Server, params)
 	if err != nil {
@@ -293,8 +679,32 @@ func (c *Client) GetSilences(ctx context.Context, params *GetSilencesParams, req
 	return c.Client.Do(req)
 }
 
-// NewGetAlertsRequest generates requests for GetAlerts
-func NewGetAlertsRequest(server string, params *GetAlertsParams) (*http.Request, error) {
+func (c *Client) PostSilencesWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostSilencesRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostSilences(ctx context.Context, body PostSilencesJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostSilencesRequest(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+// NewGetAcksRequest generates requests for GetAcks
+func NewGetAcksRequest(server string) (*http.Request, error) {
 	var err error
 
 	serverURL, err := url.Parse(server)
@@ -302,7 +712,7 @@ func NewGetAlertsRequest(server string, params *GetAlertsParams) (*http.Request,
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/alerts")
+	operationPath := fmt.Sprintf("/acks")
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -312,36 +722,271 @@ func NewGetAlertsRequest(server string, params *GetAlertsParams) (*http.Request,
 		return nil, err
 	}
 
-	if params != nil {
-		queryValues := queryURL.Query()
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
 
-		if params.Active != nil {
+	return req, nil
+}
 
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "active", runtime.ParamLocationQuery, *params.Active); err != nil {
-				return nil, err
-			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-				return nil, err
-			} else {
-				for k, v := range parsed {
-					for _, v2 := range v {
-						queryValues.Add(k, v2)
-					}
-				}
-			}
+// NewGetActionRequest generates requests for GetAction
+func NewGetActionRequest(server string, action GetActionParamsAction, params *GetActionParams) (*http.Request, error) {
+	var err error
 
-		}
+	var pathParam0 string
 
-		if params.Silenced != nil {
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "action", runtime.ParamLocationPath, action)
+	if err != nil {
+		return nil, err
+	}
 
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "silenced", runtime.ParamLocationQuery, *params.Silenced); err != nil {
-				return nil, err
-			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-				return nil, err
-			} else {
-				for k, v := range parsed {
-					for _, v2 := range v {
-						queryValues.Add(k, v2)
-					}
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/actions/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "tenant", runtime.ParamLocationQuery, params.Tenant); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "fingerprint", runtime.ParamLocationQuery, params.Fingerprint); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+		if params.Duration != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "duration", runtime.ParamLocationQuery, *params.Duration); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "expires", runtime.ParamLocationQuery, params.Expires); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "signature", runtime.ParamLocationQuery, params.Signature); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewPostActionRequest generates requests for PostAction
+func NewPostActionRequest(server string, action PostActionParamsAction, params *PostActionParams) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "action", runtime.ParamLocationPath, action)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/actions/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "tenant", runtime.ParamLocationQuery, params.Tenant); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "fingerprint", runtime.ParamLocationQuery, params.Fingerprint); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+		if params.Duration != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "duration", runtime.ParamLocationQuery, *params.Duration); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "expires", runtime.ParamLocationQuery, params.Expires); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "signature", runtime.ParamLocationQuery, params.Signature); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetAlertsRequest generates requests for GetAlerts
+func NewGetAlertsRequest(server string, params *GetAlertsParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/alerts")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.Active != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "active", runtime.ParamLocationQuery, *params.Active); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Silenced != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "silenced", runtime.ParamLocationQuery, *params.Silenced); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
 				}
 			}
 
@@ -551,16 +1196,23 @@ func NewGetAlertGroupsRequest(server string, params *GetAlertGroupsParams) (*htt
 	return req, nil
 }
 
-// NewGetSilencesRequest generates requests for GetSilences
-func NewGetSilencesRequest(server string, params *GetSilencesParams) (*http.Request, error) {
+// NewGetTenantAlertRequest generates requests for GetTenantAlert
+func NewGetTenantAlertRequest(server string, fingerprint string, params *GetTenantAlertParams) (*http.Request, error) {
 	var err error
 
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "fingerprint", runtime.ParamLocationPath, fingerprint)
+	if err != nil {
+		return nil, err
+	}
+
 	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/silences")
+	operationPath := fmt.Sprintf("/alerts/%s", pathParam0)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -573,9 +1225,9 @@ func NewGetSilencesRequest(server string, params *GetSilencesParams) (*http.Requ
 	if params != nil {
 		queryValues := queryURL.Query()
 
-		if params.Filter != nil {
+		if params.Tenant != nil {
 
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "filter", runtime.ParamLocationQuery, *params.Filter); err != nil {
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "tenant", runtime.ParamLocationQuery, *params.Tenant); err != nil {
 				return nil, err
 			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
 				return nil, err
@@ -600,705 +1252,2722 @@ func NewGetSilencesRequest(server string, params *GetSilencesParams) (*http.Requ
 	return req, nil
 }
 
-func (c *Client) applyEditors(ctx context.Context, req *http.Request, additionalEditors []RequestEditorFn) error {
-	for _, r := range c.RequestEditors {
-		if err := r(ctx, req); err != nil {
-			return err
-		}
+// NewDeleteAckRequest generates requests for DeleteAck
+func NewDeleteAckRequest(server string, fingerprint string) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "fingerprint", runtime.ParamLocationPath, fingerprint)
+	if err != nil {
+		return nil, err
 	}
-	for _, r := range additionalEditors {
-		if err := r(ctx, req); err != nil {
-			return err
-		}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
 	}
-	return nil
-}
 
-// ClientWithResponses builds on ClientInterface to offer response payloads
-type ClientWithResponses struct {
-	ClientInterface
-}
+	operationPath := fmt.Sprintf("/alerts/%s/ack", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
 
-// NewClientWithResponses creates a new ClientWithResponses, which wraps
-// Client with return type handling
-func NewClientWithResponses(server string, opts ...ClientOption) (*ClientWithResponses, error) {
-	client, err := NewClient(server, opts...)
+	queryURL, err := serverURL.Parse(operationPath)
 	if err != nil {
 		return nil, err
 	}
-	return &ClientWithResponses{client}, nil
+
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
 }
 
-// WithBaseURL overrides the baseURL.
-func WithBaseURL(baseURL string) ClientOption {
-	return func(c *Client) error {
-		newBaseURL, err := url.Parse(baseURL)
-		if err != nil {
-			return err
-		}
-		c.Server = newBaseURL.String()
-		return nil
+// NewPostAckRequest calls the generic PostAck builder with application/json body
+func NewPostAckRequest(server string, fingerprint string, body PostAckJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
 	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPostAckRequestWithBody(server, fingerprint, "application/json", bodyReader)
 }
 
-// ClientWithResponsesInterface is the interface specification for the client with responses above.
-type ClientWithResponsesInterface interface {
-	// GetAlertsWithResponse request
-	GetAlertsWithResponse(ctx context.Context, params *GetAlertsParams, reqEditors ...RequestEditorFn) (*GetAlertsResponse, error)
+// NewPostAckRequestWithBody generates requests for PostAck with any type of body
+func NewPostAckRequestWithBody(server string, fingerprint string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
 
-	// GetAlertGroupsWithResponse request
-	GetAlertGroupsWithResponse(ctx context.Context, params *GetAlertGroupsParams, reqEditors ...RequestEditorFn) (*GetAlertGroupsResponse, error)
+	var pathParam0 string
 
-	// GetSilencesWithResponse request
-	GetSilencesWithResponse(ctx context.Context, params *GetSilencesParams, reqEditors ...RequestEditorFn) (*GetSilencesResponse, error)
-}
-
-type GetAlertsResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *GettableAlerts
-	JSON400      *string
-	JSON500      *string
-}
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "fingerprint", runtime.ParamLocationPath, fingerprint)
+	if err != nil {
+		return nil, err
+	}
 
-// Status returns HTTPResponse.Status
-func (r GetAlertsResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r GetAlertsResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	operationPath := fmt.Sprintf("/alerts/%s/ack", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
 	}
-	return 0
-}
 
-type GetAlertGroupsResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *AlertGroups
-	JSON400      *string
-	JSON500      *string
-}
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
 
-// Status returns HTTPResponse.Status
-func (r GetAlertGroupsResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r GetAlertGroupsResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+// NewGetTenantConfigsRequest generates requests for GetTenantConfigs
+func NewGetTenantConfigsRequest(server string, params *GetTenantConfigsParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
 	}
-	return 0
-}
 
-type GetSilencesResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *GettableSilences
-	JSON400      *string
-	JSON500      *string
-}
+	operationPath := fmt.Sprintf("/configs")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
 
-// Status returns HTTPResponse.Status
-func (r GetSilencesResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r GetSilencesResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.Tenant != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "tenant", runtime.ParamLocationQuery, *params.Tenant); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
 	}
-	return 0
-}
 
-// GetAlertsWithResponse request returning *GetAlertsResponse
-func (c *ClientWithResponses) GetAlertsWithResponse(ctx context.Context, params *GetAlertsParams, reqEditors ...RequestEditorFn) (*GetAlertsResponse, error) {
-	rsp, err := c.GetAlerts(ctx, params, reqEditors...)
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
 	if err != nil {
 		return nil, err
 	}
-	return ParseGetAlertsResponse(rsp)
+
+	return req, nil
 }
 
-// GetAlertGroupsWithResponse request returning *GetAlertGroupsResponse
-func (c *ClientWithResponses) GetAlertGroupsWithResponse(ctx context.Context, params *GetAlertGroupsParams, reqEditors ...RequestEditorFn) (*GetAlertGroupsResponse, error) {
-	rsp, err := c.GetAlertGroups(ctx, params, reqEditors...)
+// NewPostTenantConfigsRequest calls the generic PostTenantConfigs builder with application/json body
+func NewPostTenantConfigsRequest(server string, body PostTenantConfigsJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
 	if err != nil {
 		return nil, err
 	}
-	return ParseGetAlertGroupsResponse(rsp)
+	bodyReader = bytes.NewReader(buf)
+	return NewPostTenantConfigsRequestWithBody(server, "application/json", bodyReader)
 }
 
-// GetSilencesWithResponse request returning *GetSilencesResponse
-func (c *ClientWithResponses) GetSilencesWithResponse(ctx context.Context, params *GetSilencesParams, reqEditors ...RequestEditorFn) (*GetSilencesResponse, error) {
-	rsp, err := c.GetSilences(ctx, params, reqEditors...)
+// NewPostTenantConfigsRequestWithBody generates requests for PostTenantConfigs with any type of body
+func NewPostTenantConfigsRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
-	return ParseGetSilencesResponse(rsp)
-}
 
-// ParseGetAlertsResponse parses an HTTP response from a GetAlertsWithResponse call
-func ParseGetAlertsResponse(rsp *http.Response) (*GetAlertsResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
-	defer func() { _ = rsp.Body.Close() }()
+	operationPath := fmt.Sprintf("/configs")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
 	if err != nil {
 		return nil, err
 	}
 
-	response := &GetAlertsResponse{
-		Body:         bodyBytes,
-		HTTPResponse: rsp,
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
 	}
 
-	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest GettableAlerts
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON200 = &dest
+	req.Header.Add("Content-Type", contentType)
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
-		var dest string
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON400 = &dest
+	return req, nil
+}
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
-		var dest string
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON500 = &dest
+// NewGetRulesRequest generates requests for GetRules
+func NewGetRulesRequest(server string, params *GetRulesParams) (*http.Request, error) {
+	var err error
 
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
 	}
 
-	return response, nil
-}
+	operationPath := fmt.Sprintf("/rules")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
 
-// ParseGetAlertGroupsResponse parses an HTTP response from a GetAlertGroupsWithResponse call
-func ParseGetAlertGroupsResponse(rsp *http.Response) (*GetAlertGroupsResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
-	defer func() { _ = rsp.Body.Close() }()
+	queryURL, err := serverURL.Parse(operationPath)
 	if err != nil {
 		return nil, err
 	}
 
-	response := &GetAlertGroupsResponse{
-		Body:         bodyBytes,
-		HTTPResponse: rsp,
-	}
+	if params != nil {
+		queryValues := queryURL.Query()
 
-	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest AlertGroups
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON200 = &dest
+		if params.Type != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "type", runtime.ParamLocationQuery, *params.Type); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
-		var dest string
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
 		}
-		response.JSON400 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
-		var dest string
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
+		if params.Alerts != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "alerts", runtime.ParamLocationQuery, *params.Alerts); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
 		}
-		response.JSON500 = &dest
 
+		queryURL.RawQuery = queryValues.Encode()
 	}
 
-	return response, nil
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
 }
 
-// ParseGetSilencesResponse parses an HTTP response from a GetSilencesWithResponse call
-func ParseGetSilencesResponse(rsp *http.Response) (*GetSilencesResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
-	defer func() { _ = rsp.Body.Close() }()
+// NewGetSilencesRequest generates requests for GetSilences
+func NewGetSilencesRequest(server string, params *GetSilencesParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
 
-	response := &GetSilencesResponse{
-		Body:         bodyBytes,
-		HTTPResponse: rsp,
+	operationPath := fmt.Sprintf("/silences")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
 	}
 
-	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest GettableSilences
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON200 = &dest
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
-		var dest string
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON400 = &dest
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.Filter != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "filter", runtime.ParamLocationQuery, *params.Filter); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
-		var dest string
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
 		}
-		response.JSON500 = &dest
 
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
 	}
 
-	return response, nil
+	return req, nil
 }
 
-// ServerInterface represents all server handlers.
-type ServerInterface interface {
+// NewPostSilencesRequest calls the generic PostSilences builder with application/json body
+func NewPostSilencesRequest(server string, body PostSilencesJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPostSilencesRequestWithBody(server, "application/json", bodyReader)
+}
 
-	// (GET /alerts)
-	GetAlerts(w http.ResponseWriter, r *http.Request, params GetAlertsParams)
+// NewPostSilencesRequestWithBody generates requests for PostSilences with any type of body
+func NewPostSilencesRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
 
-	// (GET /alerts/groups)
-	GetAlertGroups(w http.ResponseWriter, r *http.Request, params GetAlertGroupsParams)
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
 
-	// (GET /silences)
-	GetSilences(w http.ResponseWriter, r *http.Request, params GetSilencesParams)
-}
+	operationPath := fmt.Sprintf("/silences")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
 
-// Unimplemented server implementation that returns http.StatusNotImplemented for each endpoint.
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
 
-type Unimplemented struct{}
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
 
-// (GET /alerts)
-func (_ Unimplemented) GetAlerts(w http.ResponseWriter, r *http.Request, params GetAlertsParams) {
-	w.WriteHeader(http.StatusNotImplemented)
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
 }
 
-// (GET /alerts/groups)
-func (_ Unimplemented) GetAlertGroups(w http.ResponseWriter, r *http.Request, params GetAlertGroupsParams) {
-	w.WriteHeader(http.StatusNotImplemented)
+func (c *Client) applyEditors(ctx context.Context, req *http.Request, additionalEditors []RequestEditorFn) error {
+	for _, r := range c.RequestEditors {
+		if err := r(ctx, req); err != nil {
+			return err
+		}
+	}
+	for _, r := range additionalEditors {
+		if err := r(ctx, req); err != nil {
+			return err
+		}
+	}
+	return nil
 }
 
-// (GET /silences)
-func (_ Unimplemented) GetSilences(w http.ResponseWriter, r *http.Request, params GetSilencesParams) {
-	w.WriteHeader(http.StatusNotImplemented)
+// ClientWithResponses builds on ClientInterface to offer response payloads
+type ClientWithResponses struct {
+	ClientInterface
 }
 
-// ServerInterfaceWrapper converts contexts to parameters.
-type ServerInterfaceWrapper struct {
-	Handler            ServerInterface
-	HandlerMiddlewares []MiddlewareFunc
-	ErrorHandlerFunc   func(w http.ResponseWriter, r *http.Request, err error)
+// NewClientWithResponses creates a new ClientWithResponses, which wraps
+// Client with return type handling
+func NewClientWithResponses(server string, opts ...ClientOption) (*ClientWithResponses, error) {
+	client, err := NewClient(server, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &ClientWithResponses{client}, nil
 }
 
-type MiddlewareFunc func(http.Handler) http.Handler
+// WithBaseURL overrides the baseURL.
+func WithBaseURL(baseURL string) ClientOption {
+	return func(c *Client) error {
+		newBaseURL, err := url.Parse(baseURL)
+		if err != nil {
+			return err
+		}
+		c.Server = newBaseURL.String()
+		return nil
+	}
+}
 
-// GetAlerts operation middleware
-func (siw *ServerInterfaceWrapper) GetAlerts(w http.ResponseWriter, r *http.Request) {
+// ClientWithResponsesInterface is the interface specification for the client with responses above.
+type ClientWithResponsesInterface interface {
+	// GetAcksWithResponse request
+	GetAcksWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetAcksResponse, error)
 
-	var err error
+	// GetActionWithResponse request
+	GetActionWithResponse(ctx context.Context, action GetActionParamsAction, params *GetActionParams, reqEditors ...RequestEditorFn) (*GetActionResponse, error)
 
-	// Parameter object where we will unmarshal all parameters from the context
-	var params GetAlertsParams
+	// PostActionWithResponse request
+	PostActionWithResponse(ctx context.Context, action PostActionParamsAction, params *PostActionParams, reqEditors ...RequestEditorFn) (*PostActionResponse, error)
 
-	// ------------- Optional query parameter "active" -------------
+	// GetAlertsWithResponse request
+	GetAlertsWithResponse(ctx context.Context, params *GetAlertsParams, reqEditors ...RequestEditorFn) (*GetAlertsResponse, error)
 
-	err = runtime.BindQueryParameter("form", true, false, "active", r.URL.Query(), &params.Active)
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "active", Err: err})
-		return
-	}
+	// GetAlertGroupsWithResponse request
+	GetAlertGroupsWithResponse(ctx context.Context, params *GetAlertGroupsParams, reqEditors ...RequestEditorFn) (*GetAlertGroupsResponse, error)
 
-	// ------------- Optional query parameter "silenced" -------------
+	// GetTenantAlertWithResponse request
+	GetTenantAlertWithResponse(ctx context.Context, fingerprint string, params *GetTenantAlertParams, reqEditors ...RequestEditorFn) (*GetTenantAlertResponse, error)
 
-	err = runtime.BindQueryParameter("form", true, false, "silenced", r.URL.Query(), &params.Silenced)
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "silenced", Err: err})
-		return
+	// DeleteAckWithResponse request
+	DeleteAckWithResponse(ctx context.Context, fingerprint string, reqEditors ...RequestEditorFn) (*DeleteAckResponse, error)
+
+	// PostAckWithBodyWithResponse request with any body
+	PostAckWithBodyWithResponse(ctx context.Context, fingerprint string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostAckResponse, error)
+
+	PostAckWithResponse(ctx context.Context, fingerprint string, body PostAckJSONRequestBody, reqEditors ...RequestEditorFn) (*PostAckResponse, error)
+
+	// GetTenantConfigsWithResponse request
+	GetTenantConfigsWithResponse(ctx context.Context, params *GetTenantConfigsParams, reqEditors ...RequestEditorFn) (*GetTenantConfigsResponse, error)
+
+	// PostTenantConfigsWithBodyWithResponse request with any body
+	PostTenantConfigsWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostTenantConfigsResponse, error)
+
+	PostTenantConfigsWithResponse(ctx context.Context, body PostTenantConfigsJSONRequestBody, reqEditors ...RequestEditorFn) (*PostTenantConfigsResponse, error)
+
+	// GetRulesWithResponse request
+	GetRulesWithResponse(ctx context.Context, params *GetRulesParams, reqEditors ...RequestEditorFn) (*GetRulesResponse, error)
+
+	// GetSilencesWithResponse request
+	GetSilencesWithResponse(ctx context.Context, params *GetSilencesParams, reqEditors ...RequestEditorFn) (*GetSilencesResponse, error)
+
+	// PostSilencesWithBodyWithResponse request with any body
+	PostSilencesWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostSilencesResponse, error)
+
+	PostSilencesWithResponse(ctx context.Context, body PostSilencesJSONRequestBody, reqEditors ...RequestEditorFn) (*PostSilencesResponse, error)
+}
+
+type GetAcksResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *[]Ack
+}
+
+// Status returns HTTPResponse.Status
+func (r GetAcksResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
 	}
+	return http.StatusText(0)
+}
 
-	// ------------- Optional query parameter "inhibited" -------------
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetAcksResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
 
-	err = runtime.BindQueryParameter("form", true, false, "inhibited", r.URL.Query(), &params.Inhibited)
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "inhibited", Err: err})
-		return
+type GetActionResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON400      *string
+	JSON403      *string
+	JSON500      *string
+}
+
+// Status returns HTTPResponse.Status
+func (r GetActionResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
 	}
+	return http.StatusText(0)
+}
 
-	// ------------- Optional query parameter "unprocessed" -------------
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetActionResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
 
-	err = runtime.BindQueryParameter("form", true, false, "unprocessed", r.URL.Query(), &params.Unprocessed)
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "unprocessed", Err: err})
-		return
+type PostActionResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *ActionResult
+	JSON400      *string
+	JSON403      *string
+	JSON404      *string
+	JSON500      *string
+}
+
+// Status returns HTTPResponse.Status
+func (r PostActionResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
 	}
+	return http.StatusText(0)
+}
 
-	// ------------- Optional query parameter "filter" -------------
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostActionResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
 
-	err = runtime.BindQueryParameter("form", true, false, "filter", r.URL.Query(), &params.Filter)
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "filter", Err: err})
-		return
+type GetAlertsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *GettableAlerts
+	JSON400      *string
+	JSON500      *string
+}
+
+// Status returns HTTPResponse.Status
+func (r GetAlertsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
 	}
+	return http.StatusText(0)
+}
 
-	// ------------- Optional query parameter "receiver" -------------
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetAlertsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
 
-	err = runtime.BindQueryParameter("form", true, false, "receiver", r.URL.Query(), &params.Receiver)
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "receiver", Err: err})
-		return
+type GetAlertGroupsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *AlertGroups
+	JSON400      *string
+	JSON500      *string
+}
+
+// Status returns HTTPResponse.Status
+func (r GetAlertGroupsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
 	}
+	return http.StatusText(0)
+}
 
-	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetAlerts(w, r, params)
-	}))
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetAlertGroupsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetTenantAlertResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *TenantAlert
+	JSON400      *string
+	JSON404      *string
+	JSON500      *string
+}
+
+// Status returns HTTPResponse.Status
+func (r GetTenantAlertResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetTenantAlertResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DeleteAckResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON404      *string
+	JSON500      *string
+}
+
+// Status returns HTTPResponse.Status
+func (r DeleteAckResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeleteAckResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostAckResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *Ack
+	JSON400      *string
+	JSON500      *string
+}
+
+// Status returns HTTPResponse.Status
+func (r PostAckResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostAckResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetTenantConfigsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *TenantConfigs
+	JSON400      *string
+	JSON500      *string
+}
+
+// Status returns HTTPResponse.Status
+func (r GetTenantConfigsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetTenantConfigsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostTenantConfigsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *TenantConfigPushResults
+	JSON400      *ConfigFindings
+	JSON403      *string
+	JSON500      *string
+}
+
+// Status returns HTTPResponse.Status
+func (r PostTenantConfigsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostTenantConfigsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetRulesResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *TenantRuleGroups
+	JSON500      *string
+}
+
+// Status returns HTTPResponse.Status
+func (r GetRulesResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetRulesResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetSilencesResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *GettableSilences
+	JSON400      *string
+	JSON500      *string
+}
+
+// Status returns HTTPResponse.Status
+func (r GetSilencesResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetSilencesResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostSilencesResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *struct {
+		SilenceID *string `json:"silenceID,omitempty"`
+	}
+	JSON400 *string
+	JSON404 *string
+	JSON500 *string
+}
+
+// Status returns HTTPResponse.Status
+func (r PostSilencesResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostSilencesResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+// GetAcksWithResponse request returning *GetAcksResponse
+func (c *ClientWithResponses) GetAcksWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetAcksResponse, error) {
+	rsp, err := c.GetAcks(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetAcksResponse(rsp)
+}
+
+// GetActionWithResponse request returning *GetActionResponse
+func (c *ClientWithResponses) GetActionWithResponse(ctx context.Context, action GetActionParamsAction, params *GetActionParams, reqEditors ...RequestEditorFn) (*GetActionResponse, error) {
+	rsp, err := c.GetAction(ctx, action, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetActionResponse(rsp)
+}
+
+// PostActionWithResponse request returning *PostActionResponse
+func (c *ClientWithResponses) PostActionWithResponse(ctx context.Context, action PostActionParamsAction, params *PostActionParams, reqEditors ...RequestEditorFn) (*PostActionResponse, error) {
+	rsp, err := c.PostAction(ctx, action, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostActionResponse(rsp)
+}
+
+// GetAlertsWithResponse request returning *GetAlertsResponse
+func (c *ClientWithResponses) GetAlertsWithResponse(ctx context.Context, params *GetAlertsParams, reqEditors ...RequestEditorFn) (*GetAlertsResponse, error) {
+	rsp, err := c.GetAlerts(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetAlertsResponse(rsp)
+}
+
+// GetAlertGroupsWithResponse request returning *GetAlertGroupsResponse
+func (c *ClientWithResponses) GetAlertGroupsWithResponse(ctx context.Context, params *GetAlertGroupsParams, reqEditors ...RequestEditorFn) (*GetAlertGroupsResponse, error) {
+	rsp, err := c.GetAlertGroups(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetAlertGroupsResponse(rsp)
+}
+
+// GetTenantAlertWithResponse request returning *GetTenantAlertResponse
+func (c *ClientWithResponses) GetTenantAlertWithResponse(ctx context.Context, fingerprint string, params *GetTenantAlertParams, reqEditors ...RequestEditorFn) (*GetTenantAlertResponse, error) {
+	rsp, err := c.GetTenantAlert(ctx, fingerprint, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetTenantAlertResponse(rsp)
+}
+
+// DeleteAckWithResponse request returning *DeleteAckResponse
+func (c *ClientWithResponses) DeleteAckWithResponse(ctx context.Context, fingerprint string, reqEditors ...RequestEditorFn) (*DeleteAckResponse, error) {
+	rsp, err := c.DeleteAck(ctx, fingerprint, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeleteAckResponse(rsp)
+}
+
+// PostAckWithBodyWithResponse request with arbitrary body returning *PostAckResponse
+func (c *ClientWithResponses) PostAckWithBodyWithResponse(ctx context.Context, fingerprint string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostAckResponse, error) {
+	rsp, err := c.PostAckWithBody(ctx, fingerprint, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostAckResponse(rsp)
+}
+
+func (c *ClientWithResponses) PostAckWithResponse(ctx context.Context, fingerprint string, body PostAckJSONRequestBody, reqEditors ...RequestEditorFn) (*PostAckResponse, error) {
+	rsp, err := c.PostAck(ctx, fingerprint, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostAckResponse(rsp)
+}
+
+// GetTenantConfigsWithResponse request returning *GetTenantConfigsResponse
+func (c *ClientWithResponses) GetTenantConfigsWithResponse(ctx context.Context, params *GetTenantConfigsParams, reqEditors ...RequestEditorFn) (*GetTenantConfigsResponse, error) {
+	rsp, err := c.GetTenantConfigs(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetTenantConfigsResponse(rsp)
+}
+
+// PostTenantConfigsWithBodyWithResponse request with arbitrary body returning *PostTenantConfigsResponse
+func (c *ClientWithResponses) PostTenantConfigsWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostTenantConfigsResponse, error) {
+	rsp, err := c.PostTenantConfigsWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostTenantConfigsResponse(rsp)
+}
+
+func (c *ClientWithResponses) PostTenantConfigsWithResponse(ctx context.Context, body PostTenantConfigsJSONRequestBody, reqEditors ...RequestEditorFn) (*PostTenantConfigsResponse, error) {
+	rsp, err := c.PostTenantConfigs(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostTenantConfigsResponse(rsp)
+}
+
+// GetRulesWithResponse request returning *GetRulesResponse
+func (c *ClientWithResponses) GetRulesWithResponse(ctx context.Context, params *GetRulesParams, reqEditors ...RequestEditorFn) (*GetRulesResponse, error) {
+	rsp, err := c.GetRules(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetRulesResponse(rsp)
+}
+
+// GetSilencesWithResponse request returning *GetSilencesResponse
+func (c *ClientWithResponses) GetSilencesWithResponse(ctx context.Context, params *GetSilencesParams, reqEditors ...RequestEditorFn) (*GetSilencesResponse, error) {
+	rsp, err := c.GetSilences(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetSilencesResponse(rsp)
+}
+
+// PostSilencesWithBodyWithResponse request with arbitrary body returning *PostSilencesResponse
+func (c *ClientWithResponses) PostSilencesWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostSilencesResponse, error) {
+	rsp, err := c.PostSilencesWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostSilencesResponse(rsp)
+}
+
+func (c *ClientWithResponses) PostSilencesWithResponse(ctx context.Context, body PostSilencesJSONRequestBody, reqEditors ...RequestEditorFn) (*PostSilencesResponse, error) {
+	rsp, err := c.PostSilences(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostSilencesResponse(rsp)
+}
+
+// ParseGetAcksResponse parses an HTTP response from a GetAcksWithResponse call
+func ParseGetAcksResponse(rsp *http.Response) (*GetAcksResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetAcksResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest []Ack
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetActionResponse parses an HTTP response from a GetActionWithResponse call
+func ParseGetActionResponse(rsp *http.Response) (*GetActionResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetActionResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest string
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest string
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest string
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePostActionResponse parses an HTTP response from a PostActionWithResponse call
+func ParsePostActionResponse(rsp *http.Response) (*PostActionResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PostActionResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest ActionResult
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest string
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest string
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest string
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest string
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetAlertsResponse parses an HTTP response from a GetAlertsWithResponse call
+func ParseGetAlertsResponse(rsp *http.Response) (*GetAlertsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetAlertsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest GettableAlerts
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest string
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest string
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetAlertGroupsResponse parses an HTTP response from a GetAlertGroupsWithResponse call
+func ParseGetAlertGroupsResponse(rsp *http.Response) (*GetAlertGroupsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetAlertGroupsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest AlertGroups
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest string
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest string
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetTenantAlertResponse parses an HTTP response from a GetTenantAlertWithResponse call
+func ParseGetTenantAlertResponse(rsp *http.Response) (*GetTenantAlertResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetTenantAlertResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest TenantAlert
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest string
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest string
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest string
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseDeleteAckResponse parses an HTTP response from a DeleteAckWithResponse call
+func ParseDeleteAckResponse(rsp *http.Response) (*DeleteAckResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &DeleteAckResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest string
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest string
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePostAckResponse parses an HTTP response from a PostAckWithResponse call
+func ParsePostAckResponse(rsp *http.Response) (*PostAckResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PostAckResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Ack
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest string
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest string
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetTenantConfigsResponse parses an HTTP response from a GetTenantConfigsWithResponse call
+func ParseGetTenantConfigsResponse(rsp *http.Response) (*GetTenantConfigsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetTenantConfigsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest TenantConfigs
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest string
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest string
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePostTenantConfigsResponse parses an HTTP response from a PostTenantConfigsWithResponse call
+func ParsePostTenantConfigsResponse(rsp *http.Response) (*PostTenantConfigsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PostTenantConfigsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest TenantConfigPushResults
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ConfigFindings
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest string
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest string
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetRulesResponse parses an HTTP response from a GetRulesWithResponse call
+func ParseGetRulesResponse(rsp *http.Response) (*GetRulesResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetRulesResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest TenantRuleGroups
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest string
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetSilencesResponse parses an HTTP response from a GetSilencesWithResponse call
+func ParseGetSilencesResponse(rsp *http.Response) (*GetSilencesResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetSilencesResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest GettableSilences
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest string
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest string
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePostSilencesResponse parses an HTTP response from a PostSilencesWithResponse call
+func ParsePostSilencesResponse(rsp *http.Response) (*PostSilencesResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PostSilencesResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest struct {
+			SilenceID *string `json:"silenceID,omitempty"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest string
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest string
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest string
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ServerInterface represents all server handlers.
+type ServerInterface interface {
+
+	// (GET /acks)
+	GetAcks(w http.ResponseWriter, r *http.Request)
+
+	// (GET /actions/{action})
+	GetAction(w http.ResponseWriter, r *http.Request, action GetActionParamsAction, params GetActionParams)
+
+	// (POST /actions/{action})
+	PostAction(w http.ResponseWriter, r *http.Request, action PostActionParamsAction, params PostActionParams)
+
+	// (GET /alerts)
+	GetAlerts(w http.ResponseWriter, r *http.Request, params GetAlertsParams)
+
+	// (GET /alerts/groups)
+	GetAlertGroups(w http.ResponseWriter, r *http.Request, params GetAlertGroupsParams)
+
+	// (GET /alerts/{fingerprint})
+	GetTenantAlert(w http.ResponseWriter, r *http.Request, fingerprint string, params GetTenantAlertParams)
+
+	// (DELETE /alerts/{fingerprint}/ack)
+	DeleteAck(w http.ResponseWriter, r *http.Request, fingerprint string)
+
+	// (POST /alerts/{fingerprint}/ack)
+	PostAck(w http.ResponseWriter, r *http.Request, fingerprint string)
+
+	// (GET /configs)
+	GetTenantConfigs(w http.ResponseWriter, r *http.Request, params GetTenantConfigsParams)
+
+	// (POST /configs)
+	PostTenantConfigs(w http.ResponseWriter, r *http.Request)
+
+	// (GET /rules)
+	GetRules(w http.ResponseWriter, r *http.Request, params GetRulesParams)
+
+	// (GET /silences)
+	GetSilences(w http.ResponseWriter, r *http.Request, params GetSilencesParams)
+
+	// (POST /silences)
+	PostSilences(w http.ResponseWriter, r *http.Request)
+}
+
+// Unimplemented server implementation that returns http.StatusNotImplemented for each endpoint.
+
+type Unimplemented struct{}
+
+// (GET /acks)
+func (_ Unimplemented) GetAcks(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// (GET /actions/{action})
+func (_ Unimplemented) GetAction(w http.ResponseWriter, r *http.Request, action GetActionParamsAction, params GetActionParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// (POST /actions/{action})
+func (_ Unimplemented) PostAction(w http.ResponseWriter, r *http.Request, action PostActionParamsAction, params PostActionParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// (GET /alerts)
+func (_ Unimplemented) GetAlerts(w http.ResponseWriter, r *http.Request, params GetAlertsParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// (GET /alerts/groups)
+func (_ Unimplemented) GetAlertGroups(w http.ResponseWriter, r *http.Request, params GetAlertGroupsParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// (GET /alerts/{fingerprint})
+func (_ Unimplemented) GetTenantAlert(w http.ResponseWriter, r *http.Request, fingerprint string, params GetTenantAlertParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// (DELETE /alerts/{fingerprint}/ack)
+func (_ Unimplemented) DeleteAck(w http.ResponseWriter, r *http.Request, fingerprint string) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// (POST /alerts/{fingerprint}/ack)
+func (_ Unimplemented) PostAck(w http.ResponseWriter, r *http.Request, fingerprint string) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// (GET /configs)
+func (_ Unimplemented) GetTenantConfigs(w http.ResponseWriter, r *http.Request, params GetTenantConfigsParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// (POST /configs)
+func (_ Unimplemented) PostTenantConfigs(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// (GET /rules)
+func (_ Unimplemented) GetRules(w http.ResponseWriter, r *http.Request, params GetRulesParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// (GET /silences)
+func (_ Unimplemented) GetSilences(w http.ResponseWriter, r *http.Request, params GetSilencesParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// (POST /silences)
+func (_ Unimplemented) PostSilences(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// ServerInterfaceWrapper converts contexts to parameters.
+type ServerInterfaceWrapper struct {
+	Handler            ServerInterface
+	HandlerMiddlewares []MiddlewareFunc
+	ErrorHandlerFunc   func(w http.ResponseWriter, r *http.Request, err error)
+}
+
+type MiddlewareFunc func(http.Handler) http.Handler
+
+// GetAcks operation middleware
+func (siw *ServerInterfaceWrapper) GetAcks(w http.ResponseWriter, r *http.Request) {
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetAcks(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetAction operation middleware
+func (siw *ServerInterfaceWrapper) GetAction(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "action" -------------
+	var action GetActionParamsAction
+
+	err = runtime.BindStyledParameterWithOptions("simple", "action", chi.URLParam(r, "action"), &action, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "action", Err: err})
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetActionParams
+
+	// ------------- Required query parameter "tenant" -------------
+
+	if paramValue := r.URL.Query().Get("tenant"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "tenant"})
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "tenant", r.URL.Query(), &params.Tenant)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "tenant", Err: err})
+		return
+	}
+
+	// ------------- Required query parameter "fingerprint" -------------
+
+	if paramValue := r.URL.Query().Get("fingerprint"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "fingerprint"})
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "fingerprint", r.URL.Query(), &params.Fingerprint)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "fingerprint", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "duration" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "duration", r.URL.Query(), &params.Duration)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "duration", Err: err})
+		return
+	}
+
+	// ------------- Required query parameter "expires" -------------
+
+	if paramValue := r.URL.Query().Get("expires"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "expires"})
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "expires", r.URL.Query(), &params.Expires)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "expires", Err: err})
+		return
+	}
+
+	// ------------- Required query parameter "signature" -------------
+
+	if paramValue := r.URL.Query().Get("signature"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "signature"})
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "signature", r.URL.Query(), &params.Signature)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "signature", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetAction(w, r, action, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// PostAction operation middleware
+func (siw *ServerInterfaceWrapper) PostAction(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "action" -------------
+	var action PostActionParamsAction
+
+	err = runtime.BindStyledParameterWithOptions("simple", "action", chi.URLParam(r, "action"), &action, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "action", Err: err})
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params PostActionParams
+
+	// ------------- Required query parameter "tenant" -------------
+
+	if paramValue := r.URL.Query().Get("tenant"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "tenant"})
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "tenant", r.URL.Query(), &params.Tenant)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "tenant", Err: err})
+		return
+	}
+
+	// ------------- Required query parameter "fingerprint" -------------
+
+	if paramValue := r.URL.Query().Get("fingerprint"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "fingerprint"})
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "fingerprint", r.URL.Query(), &params.Fingerprint)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "fingerprint", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "duration" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "duration", r.URL.Query(), &params.Duration)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "duration", Err: err})
+		return
+	}
+
+	// ------------- Required query parameter "expires" -------------
+
+	if paramValue := r.URL.Query().Get("expires"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "expires"})
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "expires", r.URL.Query(), &params.Expires)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "expires", Err: err})
+		return
+	}
+
+	// ------------- Required query parameter "signature" -------------
+
+	if paramValue := r.URL.Query().Get("signature"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "signature"})
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "signature", r.URL.Query(), &params.Signature)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "signature", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PostAction(w, r, action, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetAlerts operation middleware
+func (siw *ServerInterfaceWrapper) GetAlerts(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetAlertsParams
+
+	// ------------- Optional query parameter "active" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "active", r.URL.Query(), &params.Active)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "active", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "silenced" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "silenced", r.URL.Query(), &params.Silenced)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "silenced", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "inhibited" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "inhibited", r.URL.Query(), &params.Inhibited)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "inhibited", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "unprocessed" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "unprocessed", r.URL.Query(), &params.Unprocessed)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "unprocessed", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "filter" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "filter", r.URL.Query(), &params.Filter)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "filter", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "receiver" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "receiver", r.URL.Query(), &params.Receiver)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "receiver", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetAlerts(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetAlertGroups operation middleware
+func (siw *ServerInterfaceWrapper) GetAlertGroups(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetAlertGroupsParams
+
+	// ------------- Optional query parameter "active" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "active", r.URL.Query(), &params.Active)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "active", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "silenced" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "silenced", r.URL.Query(), &params.Silenced)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "silenced", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "inhibited" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "inhibited", r.URL.Query(), &params.Inhibited)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "inhibited", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "muted" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "muted", r.URL.Query(), &params.Muted)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "muted", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "filter" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "filter", r.URL.Query(), &params.Filter)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "filter", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "receiver" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "receiver", r.URL.Query(), &params.Receiver)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "receiver", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetAlertGroups(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetTenantAlert operation middleware
+func (siw *ServerInterfaceWrapper) GetTenantAlert(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "fingerprint" -------------
+	var fingerprint string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "fingerprint", chi.URLParam(r, "fingerprint"), &fingerprint, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "fingerprint", Err: err})
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetTenantAlertParams
+
+	// ------------- Optional query parameter "tenant" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "tenant", r.URL.Query(), &params.Tenant)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "tenant", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetTenantAlert(w, r, fingerprint, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// DeleteAck operation middleware
+func (siw *ServerInterfaceWrapper) DeleteAck(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "fingerprint" -------------
+	var fingerprint string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "fingerprint", chi.URLParam(r, "fingerprint"), &fingerprint, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "fingerprint", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.DeleteAck(w, r, fingerprint)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// PostAck operation middleware
+func (siw *ServerInterfaceWrapper) PostAck(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "fingerprint" -------------
+	var fingerprint string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "fingerprint", chi.URLParam(r, "fingerprint"), &fingerprint, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "fingerprint", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PostAck(w, r, fingerprint)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetTenantConfigs operation middleware
+func (siw *ServerInterfaceWrapper) GetTenantConfigs(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetTenantConfigsParams
+
+	// ------------- Optional query parameter "tenant" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "tenant", r.URL.Query(), &params.Tenant)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "tenant", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetTenantConfigs(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// PostTenantConfigs operation middleware
+func (siw *ServerInterfaceWrapper) PostTenantConfigs(w http.ResponseWriter, r *http.Request) {
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PostTenantConfigs(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetRules operation middleware
+func (siw *ServerInterfaceWrapper) GetRules(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetRulesParams
+
+	// ------------- Optional query parameter "type" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "type", r.URL.Query(), &params.Type)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "type", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "alerts" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "alerts", r.URL.Query(), &params.Alerts)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "alerts", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetRules(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetSilences operation middleware
+func (siw *ServerInterfaceWrapper) GetSilences(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetSilencesParams
+
+	// ------------- Optional query parameter "filter" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "filter", r.URL.Query(), &params.Filter)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "filter", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetSilences(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// PostSilences operation middleware
+func (siw *ServerInterfaceWrapper) PostSilences(w http.ResponseWriter, r *http.Request) {
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PostSilences(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+type UnescapedCookieParamError struct {
+	ParamName string
+	Err       error
+}
+
+func (e *UnescapedCookieParamError) Error() string {
+	return fmt.Sprintf("error unescaping cookie parameter '%s'", e.ParamName)
+}
+
+func (e *UnescapedCookieParamError) Unwrap() error {
+	return e.Err
+}
+
+type UnmarshalingParamError struct {
+	ParamName string
+	Err       error
+}
+
+func (e *UnmarshalingParamError) Error() string {
+	return fmt.Sprintf("Error unmarshaling parameter %s as JSON: %s", e.ParamName, e.Err.Error())
+}
+
+func (e *UnmarshalingParamError) Unwrap() error {
+	return e.Err
+}
+
+type RequiredParamError struct {
+	ParamName string
+}
+
+func (e *RequiredParamError) Error() string {
+	return fmt.Sprintf("Query argument %s is required, but not found", e.ParamName)
+}
+
+type RequiredHeaderError struct {
+	ParamName string
+	Err       error
+}
+
+func (e *RequiredHeaderError) Error() string {
+	return fmt.Sprintf("Header parameter %s is required, but not found", e.ParamName)
+}
+
+func (e *RequiredHeaderError) Unwrap() error {
+	return e.Err
+}
+
+type InvalidParamFormatError struct {
+	ParamName string
+	Err       error
+}
+
+func (e *InvalidParamFormatError) Error() string {
+	return fmt.Sprintf("Invalid format for parameter %s: %s", e.ParamName, e.Err.Error())
+}
+
+func (e *InvalidParamFormatError) Unwrap() error {
+	return e.Err
+}
+
+type TooManyValuesForParamError struct {
+	ParamName string
+	Count     int
+}
+
+func (e *TooManyValuesForParamError) Error() string {
+	return fmt.Sprintf("Expected one value for %s, got %d", e.ParamName, e.Count)
+}
+
+// Handler creates http.Handler with routing matching OpenAPI spec.
+func Handler(si ServerInterface) http.Handler {
+	return HandlerWithOptions(si, ChiServerOptions{})
+}
+
+type ChiServerOptions struct {
+	BaseURL          string
+	BaseRouter       chi.Router
+	Middlewares      []MiddlewareFunc
+	ErrorHandlerFunc func(w http.ResponseWriter, r *http.Request, err error)
+}
+
+// HandlerFromMux creates http.Handler with routing matching OpenAPI spec based on the provided mux.
+func HandlerFromMux(si ServerInterface, r chi.Router) http.Handler {
+	return HandlerWithOptions(si, ChiServerOptions{
+		BaseRouter: r,
+	})
+}
+
+func HandlerFromMuxWithBaseURL(si ServerInterface, r chi.Router, baseURL string) http.Handler {
+	return HandlerWithOptions(si, ChiServerOptions{
+		BaseURL:    baseURL,
+		BaseRouter: r,
+	})
+}
+
+// HandlerWithOptions creates http.Handler with additional options
+func HandlerWithOptions(si ServerInterface, options ChiServerOptions) http.Handler {
+	r := options.BaseRouter
+
+	if r == nil {
+		r = chi.NewRouter()
+	}
+	if options.ErrorHandlerFunc == nil {
+		options.ErrorHandlerFunc = func(w http.ResponseWriter, r *http.Request, err error) {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+		}
+	}
+	wrapper := ServerInterfaceWrapper{
+		Handler:            si,
+		HandlerMiddlewares: options.Middlewares,
+		ErrorHandlerFunc:   options.ErrorHandlerFunc,
+	}
+
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/acks", wrapper.GetAcks)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/actions/{action}", wrapper.GetAction)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/actions/{action}", wrapper.PostAction)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/alerts", wrapper.GetAlerts)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/alerts/groups", wrapper.GetAlertGroups)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/alerts/{fingerprint}", wrapper.GetTenantAlert)
+	})
+	r.Group(func(r chi.Router) {
+		r.Delete(options.BaseURL+"/alerts/{fingerprint}/ack", wrapper.DeleteAck)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/alerts/{fingerprint}/ack", wrapper.PostAck)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/configs", wrapper.GetTenantConfigs)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/configs", wrapper.PostTenantConfigs)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/rules", wrapper.GetRules)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/silences", wrapper.GetSilences)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/silences", wrapper.PostSilences)
+	})
+
+	return r
+}
+
+type GetAcksRequestObject struct {
+}
+
+type GetAcksResponseObject interface {
+	VisitGetAcksResponse(w http.ResponseWriter) error
+}
+
+type GetAcks200JSONResponse []Ack
+
+func (response GetAcks200JSONResponse) VisitGetAcksResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetActionRequestObject struct {
+	Action GetActionParamsAction `json:"action"`
+	Params GetActionParams
+}
+
+type GetActionResponseObject interface {
+	VisitGetActionResponse(w http.ResponseWriter) error
+}
+
+type GetAction200TexthtmlResponse struct {
+	Body          io.Reader
+	ContentLength int64
+}
+
+func (response GetAction200TexthtmlResponse) VisitGetActionResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "text/html")
+	if response.ContentLength != 0 {
+		w.Header().Set("Content-Length", fmt.Sprint(response.ContentLength))
+	}
+	w.WriteHeader(200)
+
+	if closer, ok := response.Body.(io.ReadCloser); ok {
+		defer closer.Close()
+	}
+	_, err := io.Copy(w, response.Body)
+	return err
+}
+
+type GetAction303Response struct {
+}
+
+func (response GetAction303Response) VisitGetActionResponse(w http.ResponseWriter) error {
+	w.WriteHeader(303)
+	return nil
+}
+
+type GetAction400JSONResponse string
+
+func (response GetAction400JSONResponse) VisitGetActionResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetAction403JSONResponse string
+
+func (response GetAction403JSONResponse) VisitGetActionResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetAction500JSONResponse string
+
+func (response GetAction500JSONResponse) VisitGetActionResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostActionRequestObject struct {
+	Action PostActionParamsAction `json:"action"`
+	Params PostActionParams
+}
+
+type PostActionResponseObject interface {
+	VisitPostActionResponse(w http.ResponseWriter) error
+}
+
+type PostAction200JSONResponse ActionResult
+
+func (response PostAction200JSONResponse) VisitPostActionResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostAction400JSONResponse string
+
+func (response PostAction400JSONResponse) VisitPostActionResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostAction403JSONResponse string
+
+func (response PostAction403JSONResponse) VisitPostActionResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostAction404JSONResponse string
+
+func (response PostAction404JSONResponse) VisitPostActionResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostAction500JSONResponse string
+
+func (response PostAction500JSONResponse) VisitPostActionResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetAlertsRequestObject struct {
+	Params GetAlertsParams
+}
+
+type GetAlertsResponseObject interface {
+	VisitGetAlertsResponse(w http.ResponseWriter) error
+}
+
+type GetAlerts200JSONResponse GettableAlerts
+
+func (response GetAlerts200JSONResponse) VisitGetAlertsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetAlerts400JSONResponse string
 
-	for _, middleware := range siw.HandlerMiddlewares {
-		handler = middleware(handler)
-	}
+func (response GetAlerts400JSONResponse) VisitGetAlertsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
 
-	handler.ServeHTTP(w, r)
+	return json.NewEncoder(w).Encode(response)
 }
 
-// GetAlertGroups operation middleware
-func (siw *ServerInterfaceWrapper) GetAlertGroups(w http.ResponseWriter, r *http.Request) {
+type GetAlerts500JSONResponse string
 
-	var err error
+func (response GetAlerts500JSONResponse) VisitGetAlertsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
 
-	// Parameter object where we will unmarshal all parameters from the context
-	var params GetAlertGroupsParams
+	return json.NewEncoder(w).Encode(response)
+}
 
-	// ------------- Optional query parameter "active" -------------
+type GetAlertGroupsRequestObject struct {
+	Params GetAlertGroupsParams
+}
 
-	err = runtime.BindQueryParameter("form", true, false, "active", r.URL.Query(), &params.Active)
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "active", Err: err})
-		return
-	}
+type GetAlertGroupsResponseObject interface {
+	VisitGetAlertGroupsResponse(w http.ResponseWriter) error
+}
 
-	// ------------- Optional query parameter "silenced" -------------
+type GetAlertGroups200JSONResponse AlertGroups
 
-	err = runtime.BindQueryParameter("form", true, false, "silenced", r.URL.Query(), &params.Silenced)
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "silenced", Err: err})
-		return
-	}
+func (response GetAlertGroups200JSONResponse) VisitGetAlertGroupsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
 
-	// ------------- Optional query parameter "inhibited" -------------
+	return json.NewEncoder(w).Encode(response)
+}
 
-	err = runtime.BindQueryParameter("form", true, false, "inhibited", r.URL.Query(), &params.Inhibited)
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "inhibited", Err: err})
-		return
-	}
+type GetAlertGroups400JSONResponse string
 
-	// ------------- Optional query parameter "muted" -------------
+func (response GetAlertGroups400JSONResponse) VisitGetAlertGroupsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
 
-	err = runtime.BindQueryParameter("form", true, false, "muted", r.URL.Query(), &params.Muted)
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "muted", Err: err})
-		return
-	}
+	return json.NewEncoder(w).Encode(response)
+}
 
-	// ------------- Optional query parameter "filter" -------------
+type GetAlertGroups500JSONResponse string
 
-	err = runtime.BindQueryParameter("form", true, false, "filter", r.URL.Query(), &params.Filter)
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "filter", Err: err})
-		return
-	}
+func (response GetAlertGroups500JSONResponse) VisitGetAlertGroupsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
 
-	// ------------- Optional query parameter "receiver" -------------
+	return json.NewEncoder(w).Encode(response)
+}
 
-	err = runtime.BindQueryParameter("form", true, false, "receiver", r.URL.Query(), &params.Receiver)
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "receiver", Err: err})
-		return
-	}
+type GetTenantAlertRequestObject struct {
+	Fingerprint string `json:"fingerprint"`
+	Params      GetTenantAlertParams
+}
 
-	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetAlertGroups(w, r, params)
-	}))
+type GetTenantAlertResponseObject interface {
+	VisitGetTenantAlertResponse(w http.ResponseWriter) error
+}
 
-	for _, middleware := range siw.HandlerMiddlewares {
-		handler = middleware(handler)
-	}
+type GetTenantAlert200JSONResponse TenantAlert
 
-	handler.ServeHTTP(w, r)
+func (response GetTenantAlert200JSONResponse) VisitGetTenantAlertResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
 }
 
-// GetSilences operation middleware
-func (siw *ServerInterfaceWrapper) GetSilences(w http.ResponseWriter, r *http.Request) {
+type GetTenantAlert400JSONResponse string
 
-	var err error
+func (response GetTenantAlert400JSONResponse) VisitGetTenantAlertResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
 
-	// Parameter object where we will unmarshal all parameters from the context
-	var params GetSilencesParams
+	return json.NewEncoder(w).Encode(response)
+}
 
-	// ------------- Optional query parameter "filter" -------------
+type GetTenantAlert404JSONResponse string
 
-	err = runtime.BindQueryParameter("form", true, false, "filter", r.URL.Query(), &params.Filter)
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "filter", Err: err})
-		return
-	}
+func (response GetTenantAlert404JSONResponse) VisitGetTenantAlertResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
 
-	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetSilences(w, r, params)
-	}))
+	return json.NewEncoder(w).Encode(response)
+}
 
-	for _, middleware := range siw.HandlerMiddlewares {
-		handler = middleware(handler)
-	}
+type GetTenantAlert500JSONResponse string
 
-	handler.ServeHTTP(w, r)
-}
+func (response GetTenantAlert500JSONResponse) VisitGetTenantAlertResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
 
-type UnescapedCookieParamError struct {
-	ParamName string
-	Err       error
+	return json.NewEncoder(w).Encode(response)
 }
 
-func (e *UnescapedCookieParamError) Error() string {
-	return fmt.Sprintf("error unescaping cookie parameter '%s'", e.ParamName)
+type DeleteAckRequestObject struct {
+	Fingerprint string `json:"fingerprint"`
 }
 
-func (e *UnescapedCookieParamError) Unwrap() error {
-	return e.Err
+type DeleteAckResponseObject interface {
+	VisitDeleteAckResponse(w http.ResponseWriter) error
 }
 
-type UnmarshalingParamError struct {
-	ParamName string
-	Err       error
+type DeleteAck200Response struct {
 }
 
-func (e *UnmarshalingParamError) Error() string {
-	return fmt.Sprintf("Error unmarshaling parameter %s as JSON: %s", e.ParamName, e.Err.Error())
+func (response DeleteAck200Response) VisitDeleteAckResponse(w http.ResponseWriter) error {
+	w.WriteHeader(200)
+	return nil
 }
 
-func (e *UnmarshalingParamError) Unwrap() error {
-	return e.Err
-}
+type DeleteAck404JSONResponse string
 
-type RequiredParamError struct {
-	ParamName string
-}
+func (response DeleteAck404JSONResponse) VisitDeleteAckResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
 
-func (e *RequiredParamError) Error() string {
-	return fmt.Sprintf("Query argument %s is required, but not found", e.ParamName)
+	return json.NewEncoder(w).Encode(response)
 }
 
-type RequiredHeaderError struct {
-	ParamName string
-	Err       error
+type DeleteAck500JSONResponse string
+
+func (response DeleteAck500JSONResponse) VisitDeleteAckResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
 }
 
-func (e *RequiredHeaderError) Error() string {
-	return fmt.Sprintf("Header parameter %s is required, but not found", e.ParamName)
+type PostAckRequestObject struct {
+	Fingerprint string `json:"fingerprint"`
+	Body        *PostAckJSONRequestBody
 }
 
-func (e *RequiredHeaderError) Unwrap() error {
-	return e.Err
+type PostAckResponseObject interface {
+	VisitPostAckResponse(w http.ResponseWriter) error
 }
 
-type InvalidParamFormatError struct {
-	ParamName string
-	Err       error
+type PostAck200JSONResponse Ack
+
+func (response PostAck200JSONResponse) VisitPostAckResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
 }
 
-func (e *InvalidParamFormatError) Error() string {
-	return fmt.Sprintf("Invalid format for parameter %s: %s", e.ParamName, e.Err.Error())
+type PostAck400JSONResponse string
+
+func (response PostAck400JSONResponse) VisitPostAckResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
 }
 
-func (e *InvalidParamFormatError) Unwrap() error {
-	return e.Err
+type PostAck500JSONResponse string
+
+func (response PostAck500JSONResponse) VisitPostAckResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
 }
 
-type TooManyValuesForParamError struct {
-	ParamName string
-	Count     int
+type GetTenantConfigsRequestObject struct {
+	Params GetTenantConfigsParams
 }
 
-func (e *TooManyValuesForParamError) Error() string {
-	return fmt.Sprintf("Expected one value for %s, got %d", e.ParamName, e.Count)
+type GetTenantConfigsResponseObject interface {
+	VisitGetTenantConfigsResponse(w http.ResponseWriter) error
 }
 
-// Handler creates http.Handler with routing matching OpenAPI spec.
-func Handler(si ServerInterface) http.Handler {
-	return HandlerWithOptions(si, ChiServerOptions{})
+type GetTenantConfigs200JSONResponse TenantConfigs
+
+func (response GetTenantConfigs200JSONResponse) VisitGetTenantConfigsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
 }
 
-type ChiServerOptions struct {
-	BaseURL          string
-	BaseRouter       chi.Router
-	Middlewares      []MiddlewareFunc
-	ErrorHandlerFunc func(w http.ResponseWriter, r *http.Request, err error)
+type GetTenantConfigs400JSONResponse string
+
+func (response GetTenantConfigs400JSONResponse) VisitGetTenantConfigsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
 }
 
-// HandlerFromMux creates http.Handler with routing matching OpenAPI spec based on the provided mux.
-func HandlerFromMux(si ServerInterface, r chi.Router) http.Handler {
-	return HandlerWithOptions(si, ChiServerOptions{
-		BaseRouter: r,
-	})
+type GetTenantConfigs500JSONResponse string
+
+func (response GetTenantConfigs500JSONResponse) VisitGetTenantConfigsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
 }
 
-func HandlerFromMuxWithBaseURL(si ServerInterface, r chi.Router, baseURL string) http.Handler {
-	return HandlerWithOptions(si, ChiServerOptions{
-		BaseURL:    baseURL,
-		BaseRouter: r,
-	})
+type PostTenantConfigsRequestObject struct {
+	Body *PostTenantConfigsJSONRequestBody
 }
 
-// HandlerWithOptions creates http.Handler with additional options
-func HandlerWithOptions(si ServerInterface, options ChiServerOptions) http.Handler {
-	r := options.BaseRouter
+type PostTenantConfigsResponseObject interface {
+	VisitPostTenantConfigsResponse(w http.ResponseWriter) error
+}
 
-	if r == nil {
-		r = chi.NewRouter()
-	}
-	if options.ErrorHandlerFunc == nil {
-		options.ErrorHandlerFunc = func(w http.ResponseWriter, r *http.Request, err error) {
-			http.Error(w, err.Error(), http.StatusBadRequest)
-		}
-	}
-	wrapper := ServerInterfaceWrapper{
-		Handler:            si,
-		HandlerMiddlewares: options.Middlewares,
-		ErrorHandlerFunc:   options.ErrorHandlerFunc,
-	}
+type PostTenantConfigs200JSONResponse TenantConfigPushResults
 
-	r.Group(func(r chi.Router) {
-		r.Get(options.BaseURL+"/alerts", wrapper.GetAlerts)
-	})
-	r.Group(func(r chi.Router) {
-		r.Get(options.BaseURL+"/alerts/groups", wrapper.GetAlertGroups)
-	})
-	r.Group(func(r chi.Router) {
-		r.Get(options.BaseURL+"/silences", wrapper.GetSilences)
-	})
+func (response PostTenantConfigs200JSONResponse) VisitPostTenantConfigsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
 
-	return r
+	return json.NewEncoder(w).Encode(response)
 }
 
-type GetAlertsRequestObject struct {
-	Params GetAlertsParams
+type PostTenantConfigs400JSONResponse ConfigFindings
+
+func (response PostTenantConfigs400JSONResponse) VisitPostTenantConfigsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
 }
 
-type GetAlertsResponseObject interface {
-	VisitGetAlertsResponse(w http.ResponseWriter) error
+type PostTenantConfigs403JSONResponse string
+
+func (response PostTenantConfigs403JSONResponse) VisitPostTenantConfigsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
 }
 
-type GetAlerts200JSONResponse GettableAlerts
+type PostTenantConfigs500JSONResponse string
 
-func (response GetAlerts200JSONResponse) VisitGetAlertsResponse(w http.ResponseWriter) error {
+func (response PostTenantConfigs500JSONResponse) VisitPostTenantConfigsResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	w.WriteHeader(500)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type GetAlerts400JSONResponse string
+type GetRulesRequestObject struct {
+	Params GetRulesParams
+}
 
-func (response GetAlerts400JSONResponse) VisitGetAlertsResponse(w http.ResponseWriter) error {
+type GetRulesResponseObject interface {
+	VisitGetRulesResponse(w http.ResponseWriter) error
+}
+
+type GetRules200JSONResponse TenantRuleGroups
+
+func (response GetRules200JSONResponse) VisitGetRulesResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
+	w.WriteHeader(200)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type GetAlerts500JSONResponse string
+type GetRules500JSONResponse string
 
-func (response GetAlerts500JSONResponse) VisitGetAlertsResponse(w http.ResponseWriter) error {
+func (response GetRules500JSONResponse) VisitGetRulesResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(500)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type GetAlertGroupsRequestObject struct {
-	Params GetAlertGroupsParams
+type GetSilencesRequestObject struct {
+	Params GetSilencesParams
 }
 
-type GetAlertGroupsResponseObject interface {
-	VisitGetAlertGroupsResponse(w http.ResponseWriter) error
+type GetSilencesResponseObject interface {
+	VisitGetSilencesResponse(w http.ResponseWriter) error
 }
 
-type GetAlertGroups200JSONResponse AlertGroups
+type GetSilences200JSONResponse GettableSilences
 
-func (response GetAlertGroups200JSONResponse) VisitGetAlertGroupsResponse(w http.ResponseWriter) error {
+func (response GetSilences200JSONResponse) VisitGetSilencesResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(200)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type GetAlertGroups400JSONResponse string
+type GetSilences400JSONResponse string
 
-func (response GetAlertGroups400JSONResponse) VisitGetAlertGroupsResponse(w http.ResponseWriter) error {
+func (response GetSilences400JSONResponse) VisitGetSilencesResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(400)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type GetAlertGroups500JSONResponse string
+type GetSilences500JSONResponse string
 
-func (response GetAlertGroups500JSONResponse) VisitGetAlertGroupsResponse(w http.ResponseWriter) error {
+func (response GetSilences500JSONResponse) VisitGetSilencesResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(500)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type GetSilencesRequestObject struct {
-	Params GetSilencesParams
+type PostSilencesRequestObject struct {
+	Body *PostSilencesJSONRequestBody
 }
 
-type GetSilencesResponseObject interface {
-	VisitGetSilencesResponse(w http.ResponseWriter) error
+type PostSilencesResponseObject interface {
+	VisitPostSilencesResponse(w http.ResponseWriter) error
 }
 
-type GetSilences200JSONResponse GettableSilences
+type PostSilences200JSONResponse struct {
+	SilenceID *string `json:"silenceID,omitempty"`
+}
 
-func (response GetSilences200JSONResponse) VisitGetSilencesResponse(w http.ResponseWriter) error {
+func (response PostSilences200JSONResponse) VisitPostSilencesResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(200)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type GetSilences400JSONResponse string
+type PostSilences400JSONResponse string
 
-func (response GetSilences400JSONResponse) VisitGetSilencesResponse(w http.ResponseWriter) error {
+func (response PostSilences400JSONResponse) VisitPostSilencesResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(400)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type GetSilences500JSONResponse string
+type PostSilences404JSONResponse string
 
-func (response GetSilences500JSONResponse) VisitGetSilencesResponse(w http.ResponseWriter) error {
+func (response PostSilences404JSONResponse) VisitPostSilencesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostSilences500JSONResponse string
+
+func (response PostSilences500JSONResponse) VisitPostSilencesResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(500)
 
@@ -1308,14 +3977,44 @@ func (response GetSilences500JSONResponse) VisitGetSilencesResponse(w http.Respo
 // StrictServerInterface represents all server handlers.
 type StrictServerInterface interface {
 
+	// (GET /acks)
+	GetAcks(ctx context.Context, request GetAcksRequestObject) (GetAcksResponseObject, error)
+
+	// (GET /actions/{action})
+	GetAction(ctx context.Context, request GetActionRequestObject) (GetActionResponseObject, error)
+
+	// (POST /actions/{action})
+	PostAction(ctx context.Context, request PostActionRequestObject) (PostActionResponseObject, error)
+
 	// (GET /alerts)
 	GetAlerts(ctx context.Context, request GetAlertsRequestObject) (GetAlertsResponseObject, error)
 
 	// (GET /alerts/groups)
 	GetAlertGroups(ctx context.Context, request GetAlertGroupsRequestObject) (GetAlertGroupsResponseObject, error)
 
+	// (GET /alerts/{fingerprint})
+	GetTenantAlert(ctx context.Context, request GetTenantAlertRequestObject) (GetTenantAlertResponseObject, error)
+
+	// (DELETE /alerts/{fingerprint}/ack)
+	DeleteAck(ctx context.Context, request DeleteAckRequestObject) (DeleteAckResponseObject, error)
+
+	// (POST /alerts/{fingerprint}/ack)
+	PostAck(ctx context.Context, request PostAckRequestObject) (PostAckResponseObject, error)
+
+	// (GET /configs)
+	GetTenantConfigs(ctx context.Context, request GetTenantConfigsRequestObject) (GetTenantConfigsResponseObject, error)
+
+	// (POST /configs)
+	PostTenantConfigs(ctx context.Context, request PostTenantConfigsRequestObject) (PostTenantConfigsResponseObject, error)
+
+	// (GET /rules)
+	GetRules(ctx context.Context, request GetRulesRequestObject) (GetRulesResponseObject, error)
+
 	// (GET /silences)
 	GetSilences(ctx context.Context, request GetSilencesRequestObject) (GetSilencesResponseObject, error)
+
+	// (POST /silences)
+	PostSilences(ctx context.Context, request PostSilencesRequestObject) (PostSilencesResponseObject, error)
 }
 
 type StrictHandlerFunc = strictnethttp.StrictHTTPHandlerFunc
@@ -1347,6 +4046,84 @@ type strictHandler struct {
 	options     StrictHTTPServerOptions
 }
 
+// GetAcks operation middleware
+func (sh *strictHandler) GetAcks(w http.ResponseWriter, r *http.Request) {
+	var request GetAcksRequestObject
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetAcks(ctx, request.(GetAcksRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetAcks")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetAcksResponseObject); ok {
+		if err := validResponse.VisitGetAcksResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetAction operation middleware
+func (sh *strictHandler) GetAction(w http.ResponseWriter, r *http.Request, action GetActionParamsAction, params GetActionParams) {
+	var request GetActionRequestObject
+
+	request.Action = action
+	request.Params = params
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetAction(ctx, request.(GetActionRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetAction")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetActionResponseObject); ok {
+		if err := validResponse.VisitGetActionResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// PostAction operation middleware
+func (sh *strictHandler) PostAction(w http.ResponseWriter, r *http.Request, action PostActionParamsAction, params PostActionParams) {
+	var request PostActionRequestObject
+
+	request.Action = action
+	request.Params = params
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.PostAction(ctx, request.(PostActionRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostAction")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(PostActionResponseObject); ok {
+		if err := validResponse.VisitPostActionResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
 // GetAlerts operation middleware
 func (sh *strictHandler) GetAlerts(w http.ResponseWriter, r *http.Request, params GetAlertsParams) {
 	var request GetAlertsRequestObject
@@ -1399,6 +4176,175 @@ func (sh *strictHandler) GetAlertGroups(w http.ResponseWriter, r *http.Request,
 	}
 }
 
+// GetTenantAlert operation middleware
+func (sh *strictHandler) GetTenantAlert(w http.ResponseWriter, r *http.Request, fingerprint string, params GetTenantAlertParams) {
+	var request GetTenantAlertRequestObject
+
+	request.Fingerprint = fingerprint
+	request.Params = params
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetTenantAlert(ctx, request.(GetTenantAlertRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetTenantAlert")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetTenantAlertResponseObject); ok {
+		if err := validResponse.VisitGetTenantAlertResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// DeleteAck operation middleware
+func (sh *strictHandler) DeleteAck(w http.ResponseWriter, r *http.Request, fingerprint string) {
+	var request DeleteAckRequestObject
+
+	request.Fingerprint = fingerprint
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.DeleteAck(ctx, request.(DeleteAckRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "DeleteAck")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(DeleteAckResponseObject); ok {
+		if err := validResponse.VisitDeleteAckResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// PostAck operation middleware
+func (sh *strictHandler) PostAck(w http.ResponseWriter, r *http.Request, fingerprint string) {
+	var request PostAckRequestObject
+
+	request.Fingerprint = fingerprint
+
+	var body PostAckJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.PostAck(ctx, request.(PostAckRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostAck")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(PostAckResponseObject); ok {
+		if err := validResponse.VisitPostAckResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetTenantConfigs operation middleware
+func (sh *strictHandler) GetTenantConfigs(w http.ResponseWriter, r *http.Request, params GetTenantConfigsParams) {
+	var request GetTenantConfigsRequestObject
+
+	request.Params = params
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetTenantConfigs(ctx, request.(GetTenantConfigsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetTenantConfigs")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetTenantConfigsResponseObject); ok {
+		if err := validResponse.VisitGetTenantConfigsResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// PostTenantConfigs operation middleware
+func (sh *strictHandler) PostTenantConfigs(w http.ResponseWriter, r *http.Request) {
+	var request PostTenantConfigsRequestObject
+
+	var body PostTenantConfigsJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.PostTenantConfigs(ctx, request.(PostTenantConfigsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostTenantConfigs")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(PostTenantConfigsResponseObject); ok {
+		if err := validResponse.VisitPostTenantConfigsResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetRules operation middleware
+func (sh *strictHandler) GetRules(w http.ResponseWriter, r *http.Request, params GetRulesParams) {
+	var request GetRulesRequestObject
+
+	request.Params = params
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetRules(ctx, request.(GetRulesRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetRules")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetRulesResponseObject); ok {
+		if err := validResponse.VisitGetRulesResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
 // GetSilences operation middleware
 func (sh *strictHandler) GetSilences(w http.ResponseWriter, r *http.Request, params GetSilencesParams) {
 	var request GetSilencesRequestObject
@@ -1424,3 +4370,34 @@ func (sh *strictHandler) GetSilences(w http.ResponseWriter, r *http.Request, par
 		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
 	}
 }
+
+// PostSilences operation middleware
+func (sh *strictHandler) PostSilences(w http.ResponseWriter, r *http.Request) {
+	var request PostSilencesRequestObject
+
+	var body PostSilencesJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.PostSilences(ctx, request.(PostSilencesRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostSilences")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(PostSilencesResponseObject); ok {
+		if err := validResponse.VisitPostSilencesResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
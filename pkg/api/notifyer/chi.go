@@ -4,6 +4,7 @@
 package api
 
 import (
+	"bytes"
 	"context"
 	"encoding/json"
 	"fmt"
@@ -31,6 +32,30 @@ type Alert struct {
 	Labels       LabelSet `json:"labels"`
 }
 
+// AlertEvent defines model for alertEvent.
+type AlertEvent struct {
+	Alert       GettableAlert `json:"alert"`
+	Fingerprint string        `json:"fingerprint"`
+	Id          uint64        `json:"id"`
+
+	// NewState State after the change (unprocessed, active, suppressed or resolved)
+	NewState string `json:"newState"`
+
+	// OldState State before the change, empty for a new alert
+	OldState string    `json:"oldState"`
+	Tenant   string    `json:"tenant"`
+	Time     time.Time `json:"time"`
+}
+
+// AlertHistory defines model for alertHistory.
+type AlertHistory struct {
+	// Intervals Firing intervals overlapping the time range, ordered by start
+	Intervals []FiringInterval `json:"intervals"`
+
+	// Transitions State transitions in the time range, the oldest is the first
+	Transitions []AlertTransition `json:"transitions"`
+}
+
 // AlertStatus defines model for alertStatus.
 type AlertStatus struct {
 	InhibitedBy []string         `json:"inhibitedBy"`
@@ -42,6 +67,83 @@ type AlertStatus struct {
 // AlertStatusState defines model for AlertStatus.State.
 type AlertStatusState string
 
+// AlertTransition defines model for alertTransition.
+type AlertTransition struct {
+	EndsAt      time.Time `json:"endsAt"`
+	Fingerprint string    `json:"fingerprint"`
+	Labels      LabelSet  `json:"labels"`
+
+	// NewState State after the change (unprocessed, active, suppressed or resolved)
+	NewState string `json:"newState"`
+
+	// OldState State before the change, empty for a new alert
+	OldState string    `json:"oldState"`
+	StartsAt time.Time `json:"startsAt"`
+	Tenant   string    `json:"tenant"`
+	Time     time.Time `json:"time"`
+}
+
+// DryRunNotification defines model for dryRunNotification.
+type DryRunNotification struct {
+	// Alerts Labels of the alerts in the notification
+	Alerts []LabelSet `json:"alerts"`
+
+	// Cc Cc addresses of the dynamic recipients
+	Cc          *string  `json:"cc,omitempty"`
+	From        string   `json:"from"`
+	GroupKey    string   `json:"groupKey"`
+	GroupLabels LabelSet `json:"groupLabels"`
+	Html        string   `json:"html"`
+	Id          uint64   `json:"id"`
+
+	// Integration Integration of the receiver, for example email[0]
+	Integration string `json:"integration"`
+	Receiver    string `json:"receiver"`
+
+	// Status Status of the notification (firing or resolved)
+	Status  string    `json:"status"`
+	Subject string    `json:"subject"`
+	Text    string    `json:"text"`
+	Time    time.Time `json:"time"`
+	To      string    `json:"to"`
+}
+
+// Escalation defines model for escalation.
+type Escalation struct {
+	// EscalatedTo Receivers of the chain, which were notified
+	EscalatedTo []string `json:"escalatedTo"`
+
+	// Fingerprints Fingerprints of the notified firing alerts of the group
+	Fingerprints []string `json:"fingerprints"`
+	GroupKey     string   `json:"groupKey"`
+	GroupLabels  LabelSet `json:"groupLabels"`
+
+	// NextAt Time of the next escalation, missing if the chain is finished
+	NextAt *time.Time `json:"nextAt,omitempty"`
+
+	// NextReceiver Next receiver of the chain, missing if the chain is finished
+	NextReceiver *string `json:"nextReceiver,omitempty"`
+
+	// Receiver Receiver of the route, which got the first notification
+	Receiver string `json:"receiver"`
+	RouteId  string `json:"routeId"`
+
+	// StartedAt Time of the first notification
+	StartedAt time.Time `json:"startedAt"`
+}
+
+// FiringInterval defines model for firingInterval.
+type FiringInterval struct {
+	// End Time of the transition from active, missing if the alert is still firing
+	End         *time.Time `json:"end,omitempty"`
+	Fingerprint string     `json:"fingerprint"`
+	Labels      LabelSet   `json:"labels"`
+
+	// Start Time of the transition to active
+	Start  time.Time `json:"start"`
+	Tenant string    `json:"tenant"`
+}
+
 // GettableAlert defines model for gettableAlert.
 type GettableAlert struct {
 	Annotations  LabelSet    `json:"annotations"`
@@ -66,6 +168,123 @@ type Receiver struct {
 	Name string `json:"name"`
 }
 
+// RouteMatch defines model for routeMatch.
+type RouteMatch struct {
+	ActiveTimeIntervals *[]string `json:"activeTimeIntervals,omitempty"`
+	GroupBy             []string  `json:"groupBy"`
+	GroupByAll          bool      `json:"groupByAll"`
+	GroupInterval       string    `json:"groupInterval"`
+
+	// GroupKey Key of the alert group, like the Alertmanager dispatcher makes it
+	GroupKey          string    `json:"groupKey"`
+	GroupLabels       LabelSet  `json:"groupLabels"`
+	GroupWait         string    `json:"groupWait"`
+	Id                string    `json:"id"`
+	MuteTimeIntervals *[]string `json:"muteTimeIntervals,omitempty"`
+
+	// Path Matchers of the routes from the root to the matched route
+	Path           []string `json:"path"`
+	Receiver       string   `json:"receiver"`
+	RepeatInterval string   `json:"repeatInterval"`
+}
+
+// RouteNode defines model for routeNode.
+type RouteNode struct {
+	ActiveTimeIntervals *[]string `json:"activeTimeIntervals,omitempty"`
+	Continue            bool      `json:"continue"`
+	GroupBy             []string  `json:"groupBy"`
+	GroupByAll          bool      `json:"groupByAll"`
+	GroupInterval       string    `json:"groupInterval"`
+	GroupWait           string    `json:"groupWait"`
+
+	// Id Unique identifier of the route, built from the matchers of the path and the position
+	Id                string      `json:"id"`
+	Matchers          []string    `json:"matchers"`
+	MuteTimeIntervals *[]string   `json:"muteTimeIntervals,omitempty"`
+	Receiver          string      `json:"receiver"`
+	RepeatInterval    string      `json:"repeatInterval"`
+	Routes            []RouteNode `json:"routes"`
+}
+
+// RouteTestRequest defines model for routeTestRequest.
+type RouteTestRequest struct {
+	// Fingerprint Fingerprint of an aggregated alert, its labels are tested
+	Fingerprint *string   `json:"fingerprint,omitempty"`
+	Labels      *LabelSet `json:"labels,omitempty"`
+}
+
+// RouteTestResult defines model for routeTestResult.
+type RouteTestResult struct {
+	Labels  LabelSet     `json:"labels"`
+	Matches []RouteMatch `json:"matches"`
+}
+
+// TemplateError defines model for templateError.
+type TemplateError struct {
+	Column  *int   `json:"column,omitempty"`
+	Line    *int   `json:"line,omitempty"`
+	Message string `json:"message"`
+
+	// Template Name of the template source or message template (subject, text, html)
+	Template string `json:"template"`
+}
+
+// TemplateErrors defines model for templateErrors.
+type TemplateErrors = []TemplateError
+
+// TemplateRender defines model for templateRender.
+type TemplateRender struct {
+	Html     string `json:"html"`
+	Receiver string `json:"receiver"`
+	Subject  string `json:"subject"`
+	Text     string `json:"text"`
+}
+
+// TemplateRenderRequest defines model for templateRenderRequest.
+type TemplateRenderRequest struct {
+	Alerts      *GettableAlerts `json:"alerts,omitempty"`
+	GroupLabels *LabelSet       `json:"groupLabels,omitempty"`
+
+	// Html HTML body template, the HTML of the email receiver is used by default
+	Html *string `json:"html,omitempty"`
+
+	// Receiver Name of the receiver, the receiver of the root route is used by default
+	Receiver *string `json:"receiver,omitempty"`
+
+	// Subject Subject template, the Subject header of the email receiver is used by default
+	Subject *string `json:"subject,omitempty"`
+
+	// Templates Template sources, the configured templates are used by default
+	Templates *[]TemplateSource `json:"templates,omitempty"`
+
+	// Text Text body template, the text of the email receiver is used by default
+	Text *string `json:"text,omitempty"`
+}
+
+// TemplateSource defines model for templateSource.
+type TemplateSource struct {
+	Content string `json:"content"`
+
+	// Name Name of the source in the error messages
+	Name string `json:"name"`
+}
+
+// TemplateStatus defines model for templateStatus.
+type TemplateStatus struct {
+	// Checksum Checksum of the template files of the active template set
+	Checksum string `json:"checksum"`
+
+	// Error Error of the last reload, missing if the active template set is up to date
+	Error *string `json:"error,omitempty"`
+
+	// FailedAt Time of the last failed reload, missing if the active template set is up to date
+	FailedAt *time.Time `json:"failedAt,omitempty"`
+
+	// Files Template files of the active template set
+	Files    []string  `json:"files"`
+	LoadedAt time.Time `json:"loadedAt"`
+}
+
 // GetAlertsParams defines parameters for GetAlerts.
 type GetAlertsParams struct {
 	// Active Show active alerts
@@ -87,6 +306,39 @@ type GetAlertsParams struct {
 	Receiver *string `form:"receiver,omitempty" json:"receiver,omitempty"`
 }
 
+// GetAlertEventsParams defines parameters for GetAlertEvents.
+type GetAlertEventsParams struct {
+	// Filter A list of matchers to filter alert events by
+	Filter *[]string `form:"filter,omitempty" json:"filter,omitempty"`
+
+	// LastEventID Resume the stream after this event ID
+	LastEventID *string `json:"Last-Event-ID,omitempty"`
+}
+
+// GetAlertHistoryParams defines parameters for GetAlertHistory.
+type GetAlertHistoryParams struct {
+	// Filter A list of matchers to filter alerts by
+	Filter *[]string `form:"filter,omitempty" json:"filter,omitempty"`
+
+	// Start Start of the time range, the oldest recorded transition by default
+	Start *time.Time `form:"start,omitempty" json:"start,omitempty"`
+
+	// End End of the time range, now by default
+	End *time.Time `form:"end,omitempty" json:"end,omitempty"`
+}
+
+// GetDryRunNotificationsParams defines parameters for GetDryRunNotifications.
+type GetDryRunNotificationsParams struct {
+	// Receiver Name of the receiver to filter notifications by
+	Receiver *string `form:"receiver,omitempty" json:"receiver,omitempty"`
+}
+
+// TestRoutesJSONRequestBody defines body for TestRoutes for application/json ContentType.
+type TestRoutesJSONRequestBody = RouteTestRequest
+
+// RenderTemplatesJSONRequestBody defines body for RenderTemplates for application/json ContentType.
+type RenderTemplatesJSONRequestBody = TemplateRenderRequest
+
 // RequestEditorFn  is the function signature for the RequestEditor callback function
 type RequestEditorFn func(ctx context.Context, req *http.Request) error
 
@@ -162,6 +414,34 @@ func WithRequestEditorFn(fn RequestEditorFn) ClientOption {
 type ClientInterface interface {
 	// GetAlerts request
 	GetAlerts(ctx context.Context, params *GetAlertsParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetAlertEvents request
+	GetAlertEvents(ctx context.Context, params *GetAlertEventsParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetAlertHistory request
+	GetAlertHistory(ctx context.Context, params *GetAlertHistoryParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetEscalations request
+	GetEscalations(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetDryRunNotifications request
+	GetDryRunNotifications(ctx context.Context, params *GetDryRunNotificationsParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetRoutes request
+	GetRoutes(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// TestRoutesWithBody request with any body
+	TestRoutesWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	TestRoutes(ctx context.Context, body TestRoutesJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// RenderTemplatesWithBody request with any body
+	RenderTemplatesWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	RenderTemplates(ctx context.Context, body RenderTemplatesJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetTemplateStatus request
+	GetTemplateStatus(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
 }
 
 func (c *Client) GetAlerts(ctx context.Context, params *GetAlertsParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
@@ -176,6 +456,126 @@ func (c *Client) GetAlerts(ctx context.Context, params *GetAlertsParams, reqEdit
 	return c.Client.Do(req)
 }
 
+func (c *Client) GetAlertEvents(ctx context.Context, params *GetAlertEventsParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetAlertEventsRequest(c.Server, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetAlertHistory(ctx context.Context, params *GetAlertHistoryParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetAlertHistoryRequest(c.Server, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetEscalations(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetEscalationsRequest(c.Server)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetDryRunNotifications(ctx context.Context, params *GetDryRunNotificationsParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetDryRunNotificationsRequest(c.Server, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetRoutes(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetRoutesRequest(c.Server)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) TestRoutesWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewTestRoutesRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) TestRoutes(ctx context.Context, body TestRoutesJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewTestRoutesRequest(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) RenderTemplatesWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewRenderTemplatesRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) RenderTemplates(ctx context.Context, body RenderTemplatesJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewRenderTemplatesRequest(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetTemplateStatus(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetTemplateStatusRequest(c.Server)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
 // NewGetAlertsRequest generates requests for GetAlerts
 func NewGetAlertsRequest(server string, params *GetAlertsParams) (*http.Request, error) {
 	var err error
@@ -305,109 +705,986 @@ func NewGetAlertsRequest(server string, params *GetAlertsParams) (*http.Request,
 	return req, nil
 }
 
-func (c *Client) applyEditors(ctx context.Context, req *http.Request, additionalEditors []RequestEditorFn) error {
-	for _, r := range c.RequestEditors {
-		if err := r(ctx, req); err != nil {
-			return err
-		}
+// NewGetAlertEventsRequest generates requests for GetAlertEvents
+func NewGetAlertEventsRequest(server string, params *GetAlertEventsParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
 	}
-	for _, r := range additionalEditors {
-		if err := r(ctx, req); err != nil {
-			return err
-		}
+
+	operationPath := fmt.Sprintf("/alerts/events")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
 	}
-	return nil
-}
 
-// ClientWithResponses builds on ClientInterface to offer response payloads
-type ClientWithResponses struct {
-	ClientInterface
-}
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
 
-// NewClientWithResponses creates a new ClientWithResponses, which wraps
-// Client with return type handling
-func NewClientWithResponses(server string, opts ...ClientOption) (*ClientWithResponses, error) {
-	client, err := NewClient(server, opts...)
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.Filter != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "filter", runtime.ParamLocationQuery, *params.Filter); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
 	if err != nil {
 		return nil, err
 	}
-	return &ClientWithResponses{client}, nil
-}
 
-// WithBaseURL overrides the baseURL.
-func WithBaseURL(baseURL string) ClientOption {
-	return func(c *Client) error {
-		newBaseURL, err := url.Parse(baseURL)
-		if err != nil {
-			return err
+	if params != nil {
+
+		if params.LastEventID != nil {
+			var headerParam0 string
+
+			headerParam0, err = runtime.StyleParamWithLocation("simple", false, "Last-Event-ID", runtime.ParamLocationHeader, *params.LastEventID)
+			if err != nil {
+				return nil, err
+			}
+
+			req.Header.Set("Last-Event-ID", headerParam0)
 		}
-		c.Server = newBaseURL.String()
-		return nil
+
 	}
-}
 
-// ClientWithResponsesInterface is the interface specification for the client with responses above.
-type ClientWithResponsesInterface interface {
-	// GetAlertsWithResponse request
-	GetAlertsWithResponse(ctx context.Context, params *GetAlertsParams, reqEditors ...RequestEditorFn) (*GetAlertsResponse, error)
+	return req, nil
 }
 
-type GetAlertsResponse struct {
+// NewGetAlertHistoryRequest generates requests for GetAlertHistory
+func NewGetAlertHistoryRequest(server string, params *GetAlertHistoryParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/alerts/history")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.Filter != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "filter", runtime.ParamLocationQuery, *params.Filter); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Start != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "start", runtime.ParamLocationQuery, *params.Start); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.End != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "end", runtime.ParamLocationQuery, *params.End); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetEscalationsRequest generates requests for GetEscalations
+func NewGetEscalationsRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/escalations")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetDryRunNotificationsRequest generates requests for GetDryRunNotifications
+func NewGetDryRunNotificationsRequest(server string, params *GetDryRunNotificationsParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/notifications/dryrun")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.Receiver != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "receiver", runtime.ParamLocationQuery, *params.Receiver); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetRoutesRequest generates requests for GetRoutes
+func NewGetRoutesRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/routes")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewTestRoutesRequest calls the generic TestRoutes builder with application/json body
+func NewTestRoutesRequest(server string, body TestRoutesJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewTestRoutesRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewTestRoutesRequestWithBody generates requests for TestRoutes with any type of body
+func NewTestRoutesRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/routes/test")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewRenderTemplatesRequest calls the generic RenderTemplates builder with application/json body
+func NewRenderTemplatesRequest(server string, body RenderTemplatesJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewRenderTemplatesRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewRenderTemplatesRequestWithBody generates requests for RenderTemplates with any type of body
+func NewRenderTemplatesRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/templates/render")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewGetTemplateStatusRequest generates requests for GetTemplateStatus
+func NewGetTemplateStatusRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/templates/status")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+func (c *Client) applyEditors(ctx context.Context, req *http.Request, additionalEditors []RequestEditorFn) error {
+	for _, r := range c.RequestEditors {
+		if err := r(ctx, req); err != nil {
+			return err
+		}
+	}
+	for _, r := range additionalEditors {
+		if err := r(ctx, req); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ClientWithResponses builds on ClientInterface to offer response payloads
+type ClientWithResponses struct {
+	ClientInterface
+}
+
+// NewClientWithResponses creates a new ClientWithResponses, which wraps
+// Client with return type handling
+func NewClientWithResponses(server string, opts ...ClientOption) (*ClientWithResponses, error) {
+	client, err := NewClient(server, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &ClientWithResponses{client}, nil
+}
+
+// WithBaseURL overrides the baseURL.
+func WithBaseURL(baseURL string) ClientOption {
+	return func(c *Client) error {
+		newBaseURL, err := url.Parse(baseURL)
+		if err != nil {
+			return err
+		}
+		c.Server = newBaseURL.String()
+		return nil
+	}
+}
+
+// ClientWithResponsesInterface is the interface specification for the client with responses above.
+type ClientWithResponsesInterface interface {
+	// GetAlertsWithResponse request
+	GetAlertsWithResponse(ctx context.Context, params *GetAlertsParams, reqEditors ...RequestEditorFn) (*GetAlertsResponse, error)
+
+	// GetAlertEventsWithResponse request
+	GetAlertEventsWithResponse(ctx context.Context, params *GetAlertEventsParams, reqEditors ...RequestEditorFn) (*GetAlertEventsResponse, error)
+
+	// GetAlertHistoryWithResponse request
+	GetAlertHistoryWithResponse(ctx context.Context, params *GetAlertHistoryParams, reqEditors ...RequestEditorFn) (*GetAlertHistoryResponse, error)
+
+	// GetEscalationsWithResponse request
+	GetEscalationsWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetEscalationsResponse, error)
+
+	// GetDryRunNotificationsWithResponse request
+	GetDryRunNotificationsWithResponse(ctx context.Context, params *GetDryRunNotificationsParams, reqEditors ...RequestEditorFn) (*GetDryRunNotificationsResponse, error)
+
+	// GetRoutesWithResponse request
+	GetRoutesWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetRoutesResponse, error)
+
+	// TestRoutesWithBodyWithResponse request with any body
+	TestRoutesWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*TestRoutesResponse, error)
+
+	TestRoutesWithResponse(ctx context.Context, body TestRoutesJSONRequestBody, reqEditors ...RequestEditorFn) (*TestRoutesResponse, error)
+
+	// RenderTemplatesWithBodyWithResponse request with any body
+	RenderTemplatesWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*RenderTemplatesResponse, error)
+
+	RenderTemplatesWithResponse(ctx context.Context, body RenderTemplatesJSONRequestBody, reqEditors ...RequestEditorFn) (*RenderTemplatesResponse, error)
+
+	// GetTemplateStatusWithResponse request
+	GetTemplateStatusWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetTemplateStatusResponse, error)
+}
+
+type GetAlertsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *GettableAlerts
+	JSON400      *string
+	JSON500      *string
+}
+
+// Status returns HTTPResponse.Status
+func (r GetAlertsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetAlertsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetAlertEventsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON400      *string
+	JSON500      *string
+}
+
+// Status returns HTTPResponse.Status
+func (r GetAlertEventsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetAlertEventsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetAlertHistoryResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *AlertHistory
+	JSON400      *string
+}
+
+// Status returns HTTPResponse.Status
+func (r GetAlertHistoryResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetAlertHistoryResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetEscalationsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *[]Escalation
+}
+
+// Status returns HTTPResponse.Status
+func (r GetEscalationsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetEscalationsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetDryRunNotificationsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *[]DryRunNotification
+}
+
+// Status returns HTTPResponse.Status
+func (r GetDryRunNotificationsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetDryRunNotificationsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetRoutesResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *RouteNode
+}
+
+// Status returns HTTPResponse.Status
+func (r GetRoutesResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetRoutesResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type TestRoutesResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
-	JSON200      *GettableAlerts
+	JSON200      *RouteTestResult
 	JSON400      *string
+	JSON404      *string
+}
+
+// Status returns HTTPResponse.Status
+func (r TestRoutesResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r TestRoutesResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type RenderTemplatesResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *TemplateRender
+	JSON400      *TemplateErrors
 	JSON500      *string
 }
 
-// Status returns HTTPResponse.Status
-func (r GetAlertsResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+// Status returns HTTPResponse.Status
+func (r RenderTemplatesResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r RenderTemplatesResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetTemplateStatusResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *TemplateStatus
+}
+
+// Status returns HTTPResponse.Status
+func (r GetTemplateStatusResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetTemplateStatusResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+// GetAlertsWithResponse request returning *GetAlertsResponse
+func (c *ClientWithResponses) GetAlertsWithResponse(ctx context.Context, params *GetAlertsParams, reqEditors ...RequestEditorFn) (*GetAlertsResponse, error) {
+	rsp, err := c.GetAlerts(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetAlertsResponse(rsp)
+}
+
+// GetAlertEventsWithResponse request returning *GetAlertEventsResponse
+func (c *ClientWithResponses) GetAlertEventsWithResponse(ctx context.Context, params *GetAlertEventsParams, reqEditors ...RequestEditorFn) (*GetAlertEventsResponse, error) {
+	rsp, err := c.GetAlertEvents(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetAlertEventsResponse(rsp)
+}
+
+// GetAlertHistoryWithResponse request returning *GetAlertHistoryResponse
+func (c *ClientWithResponses) GetAlertHistoryWithResponse(ctx context.Context, params *GetAlertHistoryParams, reqEditors ...RequestEditorFn) (*GetAlertHistoryResponse, error) {
+	rsp, err := c.GetAlertHistory(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetAlertHistoryResponse(rsp)
+}
+
+// GetEscalationsWithResponse request returning *GetEscalationsResponse
+func (c *ClientWithResponses) GetEscalationsWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetEscalationsResponse, error) {
+	rsp, err := c.GetEscalations(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetEscalationsResponse(rsp)
+}
+
+// GetDryRunNotificationsWithResponse request returning *GetDryRunNotificationsResponse
+func (c *ClientWithResponses) GetDryRunNotificationsWithResponse(ctx context.Context, params *GetDryRunNotificationsParams, reqEditors ...RequestEditorFn) (*GetDryRunNotificationsResponse, error) {
+	rsp, err := c.GetDryRunNotifications(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetDryRunNotificationsResponse(rsp)
+}
+
+// GetRoutesWithResponse request returning *GetRoutesResponse
+func (c *ClientWithResponses) GetRoutesWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetRoutesResponse, error) {
+	rsp, err := c.GetRoutes(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetRoutesResponse(rsp)
+}
+
+// TestRoutesWithBodyWithResponse request with arbitrary body returning *TestRoutesResponse
+func (c *ClientWithResponses) TestRoutesWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*TestRoutesResponse, error) {
+	rsp, err := c.TestRoutesWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseTestRoutesResponse(rsp)
+}
+
+func (c *ClientWithResponses) TestRoutesWithResponse(ctx context.Context, body TestRoutesJSONRequestBody, reqEditors ...RequestEditorFn) (*TestRoutesResponse, error) {
+	rsp, err := c.TestRoutes(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseTestRoutesResponse(rsp)
+}
+
+// RenderTemplatesWithBodyWithResponse request with arbitrary body returning *RenderTemplatesResponse
+func (c *ClientWithResponses) RenderTemplatesWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*RenderTemplatesResponse, error) {
+	rsp, err := c.RenderTemplatesWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseRenderTemplatesResponse(rsp)
+}
+
+func (c *ClientWithResponses) RenderTemplatesWithResponse(ctx context.Context, body RenderTemplatesJSONRequestBody, reqEditors ...RequestEditorFn) (*RenderTemplatesResponse, error) {
+	rsp, err := c.RenderTemplates(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseRenderTemplatesResponse(rsp)
+}
+
+// GetTemplateStatusWithResponse request returning *GetTemplateStatusResponse
+func (c *ClientWithResponses) GetTemplateStatusWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetTemplateStatusResponse, error) {
+	rsp, err := c.GetTemplateStatus(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetTemplateStatusResponse(rsp)
+}
+
+// ParseGetAlertsResponse parses an HTTP response from a GetAlertsWithResponse call
+func ParseGetAlertsResponse(rsp *http.Response) (*GetAlertsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetAlertsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest GettableAlerts
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest string
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest string
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetAlertEventsResponse parses an HTTP response from a GetAlertEventsWithResponse call
+func ParseGetAlertEventsResponse(rsp *http.Response) (*GetAlertEventsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetAlertEventsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest string
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest string
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetAlertHistoryResponse parses an HTTP response from a GetAlertHistoryWithResponse call
+func ParseGetAlertHistoryResponse(rsp *http.Response) (*GetAlertHistoryResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetAlertHistoryResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest AlertHistory
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest string
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetEscalationsResponse parses an HTTP response from a GetEscalationsWithResponse call
+func ParseGetEscalationsResponse(rsp *http.Response) (*GetEscalationsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetEscalationsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest []Escalation
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetDryRunNotificationsResponse parses an HTTP response from a GetDryRunNotificationsWithResponse call
+func ParseGetDryRunNotificationsResponse(rsp *http.Response) (*GetDryRunNotificationsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetDryRunNotificationsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest []DryRunNotification
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetRoutesResponse parses an HTTP response from a GetRoutesWithResponse call
+func ParseGetRoutesResponse(rsp *http.Response) (*GetRoutesResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetRoutesResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r GetAlertsResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest RouteNode
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
 	}
-	return 0
+
+	return response, nil
 }
 
-// GetAlertsWithResponse request returning *GetAlertsResponse
-func (c *ClientWithResponses) GetAlertsWithResponse(ctx context.Context, params *GetAlertsParams, reqEditors ...RequestEditorFn) (*GetAlertsResponse, error) {
-	rsp, err := c.GetAlerts(ctx, params, reqEditors...)
+// ParseTestRoutesResponse parses an HTTP response from a TestRoutesWithResponse call
+func ParseTestRoutesResponse(rsp *http.Response) (*TestRoutesResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	return ParseGetAlertsResponse(rsp)
+
+	response := &TestRoutesResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest RouteTestResult
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest string
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest string
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	}
+
+	return response, nil
 }
 
-// ParseGetAlertsResponse parses an HTTP response from a GetAlertsWithResponse call
-func ParseGetAlertsResponse(rsp *http.Response) (*GetAlertsResponse, error) {
+// ParseRenderTemplatesResponse parses an HTTP response from a RenderTemplatesWithResponse call
+func ParseRenderTemplatesResponse(rsp *http.Response) (*RenderTemplatesResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &GetAlertsResponse{
+	response := &RenderTemplatesResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest GettableAlerts
+		var dest TemplateRender
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSON200 = &dest
 
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
-		var dest string
+		var dest TemplateErrors
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -425,11 +1702,61 @@ func ParseGetAlertsResponse(rsp *http.Response) (*GetAlertsResponse, error) {
 	return response, nil
 }
 
+// ParseGetTemplateStatusResponse parses an HTTP response from a GetTemplateStatusWithResponse call
+func ParseGetTemplateStatusResponse(rsp *http.Response) (*GetTemplateStatusResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetTemplateStatusResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest TemplateStatus
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
 // ServerInterface represents all server handlers.
 type ServerInterface interface {
 
 	// (GET /alerts)
 	GetAlerts(w http.ResponseWriter, r *http.Request, params GetAlertsParams)
+
+	// (GET /alerts/events)
+	GetAlertEvents(w http.ResponseWriter, r *http.Request, params GetAlertEventsParams)
+
+	// (GET /alerts/history)
+	GetAlertHistory(w http.ResponseWriter, r *http.Request, params GetAlertHistoryParams)
+
+	// (GET /escalations)
+	GetEscalations(w http.ResponseWriter, r *http.Request)
+
+	// (GET /notifications/dryrun)
+	GetDryRunNotifications(w http.ResponseWriter, r *http.Request, params GetDryRunNotificationsParams)
+
+	// (GET /routes)
+	GetRoutes(w http.ResponseWriter, r *http.Request)
+
+	// (POST /routes/test)
+	TestRoutes(w http.ResponseWriter, r *http.Request)
+
+	// (POST /templates/render)
+	RenderTemplates(w http.ResponseWriter, r *http.Request)
+
+	// (GET /templates/status)
+	GetTemplateStatus(w http.ResponseWriter, r *http.Request)
 }
 
 // Unimplemented server implementation that returns http.StatusNotImplemented for each endpoint.
@@ -441,6 +1768,46 @@ func (_ Unimplemented) GetAlerts(w http.ResponseWriter, r *http.Request, params
 	w.WriteHeader(http.StatusNotImplemented)
 }
 
+// (GET /alerts/events)
+func (_ Unimplemented) GetAlertEvents(w http.ResponseWriter, r *http.Request, params GetAlertEventsParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// (GET /alerts/history)
+func (_ Unimplemented) GetAlertHistory(w http.ResponseWriter, r *http.Request, params GetAlertHistoryParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// (GET /escalations)
+func (_ Unimplemented) GetEscalations(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// (GET /notifications/dryrun)
+func (_ Unimplemented) GetDryRunNotifications(w http.ResponseWriter, r *http.Request, params GetDryRunNotificationsParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// (GET /routes)
+func (_ Unimplemented) GetRoutes(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// (POST /routes/test)
+func (_ Unimplemented) TestRoutes(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// (POST /templates/render)
+func (_ Unimplemented) RenderTemplates(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// (GET /templates/status)
+func (_ Unimplemented) GetTemplateStatus(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
 // ServerInterfaceWrapper converts contexts to parameters.
 type ServerInterfaceWrapper struct {
 	Handler            ServerInterface
@@ -448,66 +1815,254 @@ type ServerInterfaceWrapper struct {
 	ErrorHandlerFunc   func(w http.ResponseWriter, r *http.Request, err error)
 }
 
-type MiddlewareFunc func(http.Handler) http.Handler
+type MiddlewareFunc func(http.Handler) http.Handler
+
+// GetAlerts operation middleware
+func (siw *ServerInterfaceWrapper) GetAlerts(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetAlertsParams
+
+	// ------------- Optional query parameter "active" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "active", r.URL.Query(), &params.Active)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "active", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "silenced" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "silenced", r.URL.Query(), &params.Silenced)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "silenced", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "inhibited" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "inhibited", r.URL.Query(), &params.Inhibited)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "inhibited", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "unprocessed" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "unprocessed", r.URL.Query(), &params.Unprocessed)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "unprocessed", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "filter" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "filter", r.URL.Query(), &params.Filter)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "filter", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "receiver" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "receiver", r.URL.Query(), &params.Receiver)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "receiver", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetAlerts(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetAlertEvents operation middleware
+func (siw *ServerInterfaceWrapper) GetAlertEvents(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetAlertEventsParams
+
+	// ------------- Optional query parameter "filter" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "filter", r.URL.Query(), &params.Filter)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "filter", Err: err})
+		return
+	}
+
+	headers := r.Header
+
+	// ------------- Optional header parameter "Last-Event-ID" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("Last-Event-ID")]; found {
+		var LastEventID string
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "Last-Event-ID", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "Last-Event-ID", valueList[0], &LastEventID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "Last-Event-ID", Err: err})
+			return
+		}
+
+		params.LastEventID = &LastEventID
+
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetAlertEvents(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetAlertHistory operation middleware
+func (siw *ServerInterfaceWrapper) GetAlertHistory(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetAlertHistoryParams
+
+	// ------------- Optional query parameter "filter" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "filter", r.URL.Query(), &params.Filter)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "filter", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "start" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "start", r.URL.Query(), &params.Start)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "start", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "end" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "end", r.URL.Query(), &params.End)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "end", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetAlertHistory(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetEscalations operation middleware
+func (siw *ServerInterfaceWrapper) GetEscalations(w http.ResponseWriter, r *http.Request) {
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetEscalations(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
 
-// GetAlerts operation middleware
-func (siw *ServerInterfaceWrapper) GetAlerts(w http.ResponseWriter, r *http.Request) {
+	handler.ServeHTTP(w, r)
+}
+
+// GetDryRunNotifications operation middleware
+func (siw *ServerInterfaceWrapper) GetDryRunNotifications(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
 	// Parameter object where we will unmarshal all parameters from the context
-	var params GetAlertsParams
+	var params GetDryRunNotificationsParams
 
-	// ------------- Optional query parameter "active" -------------
+	// ------------- Optional query parameter "receiver" -------------
 
-	err = runtime.BindQueryParameter("form", true, false, "active", r.URL.Query(), &params.Active)
+	err = runtime.BindQueryParameter("form", true, false, "receiver", r.URL.Query(), &params.Receiver)
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "active", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "receiver", Err: err})
 		return
 	}
 
-	// ------------- Optional query parameter "silenced" -------------
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetDryRunNotifications(w, r, params)
+	}))
 
-	err = runtime.BindQueryParameter("form", true, false, "silenced", r.URL.Query(), &params.Silenced)
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "silenced", Err: err})
-		return
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
 	}
 
-	// ------------- Optional query parameter "inhibited" -------------
+	handler.ServeHTTP(w, r)
+}
 
-	err = runtime.BindQueryParameter("form", true, false, "inhibited", r.URL.Query(), &params.Inhibited)
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "inhibited", Err: err})
-		return
-	}
+// GetRoutes operation middleware
+func (siw *ServerInterfaceWrapper) GetRoutes(w http.ResponseWriter, r *http.Request) {
 
-	// ------------- Optional query parameter "unprocessed" -------------
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetRoutes(w, r)
+	}))
 
-	err = runtime.BindQueryParameter("form", true, false, "unprocessed", r.URL.Query(), &params.Unprocessed)
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "unprocessed", Err: err})
-		return
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
 	}
 
-	// ------------- Optional query parameter "filter" -------------
+	handler.ServeHTTP(w, r)
+}
 
-	err = runtime.BindQueryParameter("form", true, false, "filter", r.URL.Query(), &params.Filter)
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "filter", Err: err})
-		return
+// TestRoutes operation middleware
+func (siw *ServerInterfaceWrapper) TestRoutes(w http.ResponseWriter, r *http.Request) {
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.TestRoutes(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
 	}
 
-	// ------------- Optional query parameter "receiver" -------------
+	handler.ServeHTTP(w, r)
+}
 
-	err = runtime.BindQueryParameter("form", true, false, "receiver", r.URL.Query(), &params.Receiver)
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "receiver", Err: err})
-		return
+// RenderTemplates operation middleware
+func (siw *ServerInterfaceWrapper) RenderTemplates(w http.ResponseWriter, r *http.Request) {
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.RenderTemplates(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
 	}
 
+	handler.ServeHTTP(w, r)
+}
+
+// GetTemplateStatus operation middleware
+func (siw *ServerInterfaceWrapper) GetTemplateStatus(w http.ResponseWriter, r *http.Request) {
+
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetAlerts(w, r, params)
+		siw.Handler.GetTemplateStatus(w, r)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -556,127 +2111,381 @@ type RequiredHeaderError struct {
 	Err       error
 }
 
-func (e *RequiredHeaderError) Error() string {
-	return fmt.Sprintf("Header parameter %s is required, but not found", e.ParamName)
+func (e *RequiredHeaderError) Error() string {
+	return fmt.Sprintf("Header parameter %s is required, but not found", e.ParamName)
+}
+
+func (e *RequiredHeaderError) Unwrap() error {
+	return e.Err
+}
+
+type InvalidParamFormatError struct {
+	ParamName string
+	Err       error
+}
+
+func (e *InvalidParamFormatError) Error() string {
+	return fmt.Sprintf("Invalid format for parameter %s: %s", e.ParamName, e.Err.Error())
+}
+
+func (e *InvalidParamFormatError) Unwrap() error {
+	return e.Err
+}
+
+type TooManyValuesForParamError struct {
+	ParamName string
+	Count     int
+}
+
+func (e *TooManyValuesForParamError) Error() string {
+	return fmt.Sprintf("Expected one value for %s, got %d", e.ParamName, e.Count)
+}
+
+// Handler creates http.Handler with routing matching OpenAPI spec.
+func Handler(si ServerInterface) http.Handler {
+	return HandlerWithOptions(si, ChiServerOptions{})
+}
+
+type ChiServerOptions struct {
+	BaseURL          string
+	BaseRouter       chi.Router
+	Middlewares      []MiddlewareFunc
+	ErrorHandlerFunc func(w http.ResponseWriter, r *http.Request, err error)
+}
+
+// HandlerFromMux creates http.Handler with routing matching OpenAPI spec based on the provided mux.
+func HandlerFromMux(si ServerInterface, r chi.Router) http.Handler {
+	return HandlerWithOptions(si, ChiServerOptions{
+		BaseRouter: r,
+	})
+}
+
+func HandlerFromMuxWithBaseURL(si ServerInterface, r chi.Router, baseURL string) http.Handler {
+	return HandlerWithOptions(si, ChiServerOptions{
+		BaseURL:    baseURL,
+		BaseRouter: r,
+	})
+}
+
+// HandlerWithOptions creates http.Handler with additional options
+func HandlerWithOptions(si ServerInterface, options ChiServerOptions) http.Handler {
+	r := options.BaseRouter
+
+	if r == nil {
+		r = chi.NewRouter()
+	}
+	if options.ErrorHandlerFunc == nil {
+		options.ErrorHandlerFunc = func(w http.ResponseWriter, r *http.Request, err error) {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+		}
+	}
+	wrapper := ServerInterfaceWrapper{
+		Handler:            si,
+		HandlerMiddlewares: options.Middlewares,
+		ErrorHandlerFunc:   options.ErrorHandlerFunc,
+	}
+
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/alerts", wrapper.GetAlerts)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/alerts/events", wrapper.GetAlertEvents)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/alerts/history", wrapper.GetAlertHistory)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/escalations", wrapper.GetEscalations)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/notifications/dryrun", wrapper.GetDryRunNotifications)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/routes", wrapper.GetRoutes)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/routes/test", wrapper.TestRoutes)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/templates/render", wrapper.RenderTemplates)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/templates/status", wrapper.GetTemplateStatus)
+	})
+
+	return r
+}
+
+type GetAlertsRequestObject struct {
+	Params GetAlertsParams
+}
+
+type GetAlertsResponseObject interface {
+	VisitGetAlertsResponse(w http.ResponseWriter) error
+}
+
+type GetAlerts200JSONResponse GettableAlerts
+
+func (response GetAlerts200JSONResponse) VisitGetAlertsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetAlerts400JSONResponse string
+
+func (response GetAlerts400JSONResponse) VisitGetAlertsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetAlerts500JSONResponse string
+
+func (response GetAlerts500JSONResponse) VisitGetAlertsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetAlertEventsRequestObject struct {
+	Params GetAlertEventsParams
+}
+
+type GetAlertEventsResponseObject interface {
+	VisitGetAlertEventsResponse(w http.ResponseWriter) error
+}
+
+type GetAlertEvents200TexteventStreamResponse struct {
+	Body          io.Reader
+	ContentLength int64
+}
+
+func (response GetAlertEvents200TexteventStreamResponse) VisitGetAlertEventsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "text/event-stream")
+	if response.ContentLength != 0 {
+		w.Header().Set("Content-Length", fmt.Sprint(response.ContentLength))
+	}
+	w.WriteHeader(200)
+
+	if closer, ok := response.Body.(io.ReadCloser); ok {
+		defer closer.Close()
+	}
+	_, err := io.Copy(w, response.Body)
+	return err
+}
+
+type GetAlertEvents400JSONResponse string
+
+func (response GetAlertEvents400JSONResponse) VisitGetAlertEventsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetAlertEvents500JSONResponse string
+
+func (response GetAlertEvents500JSONResponse) VisitGetAlertEventsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetAlertHistoryRequestObject struct {
+	Params GetAlertHistoryParams
+}
+
+type GetAlertHistoryResponseObject interface {
+	VisitGetAlertHistoryResponse(w http.ResponseWriter) error
+}
+
+type GetAlertHistory200JSONResponse AlertHistory
+
+func (response GetAlertHistory200JSONResponse) VisitGetAlertHistoryResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetAlertHistory400JSONResponse string
+
+func (response GetAlertHistory400JSONResponse) VisitGetAlertHistoryResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetEscalationsRequestObject struct {
+}
+
+type GetEscalationsResponseObject interface {
+	VisitGetEscalationsResponse(w http.ResponseWriter) error
+}
+
+type GetEscalations200JSONResponse []Escalation
+
+func (response GetEscalations200JSONResponse) VisitGetEscalationsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
 }
 
-func (e *RequiredHeaderError) Unwrap() error {
-	return e.Err
+type GetDryRunNotificationsRequestObject struct {
+	Params GetDryRunNotificationsParams
 }
 
-type InvalidParamFormatError struct {
-	ParamName string
-	Err       error
+type GetDryRunNotificationsResponseObject interface {
+	VisitGetDryRunNotificationsResponse(w http.ResponseWriter) error
 }
 
-func (e *InvalidParamFormatError) Error() string {
-	return fmt.Sprintf("Invalid format for parameter %s: %s", e.ParamName, e.Err.Error())
-}
+type GetDryRunNotifications200JSONResponse []DryRunNotification
 
-func (e *InvalidParamFormatError) Unwrap() error {
-	return e.Err
+func (response GetDryRunNotifications200JSONResponse) VisitGetDryRunNotificationsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
 }
 
-type TooManyValuesForParamError struct {
-	ParamName string
-	Count     int
+type GetRoutesRequestObject struct {
 }
 
-func (e *TooManyValuesForParamError) Error() string {
-	return fmt.Sprintf("Expected one value for %s, got %d", e.ParamName, e.Count)
+type GetRoutesResponseObject interface {
+	VisitGetRoutesResponse(w http.ResponseWriter) error
 }
 
-// Handler creates http.Handler with routing matching OpenAPI spec.
-func Handler(si ServerInterface) http.Handler {
-	return HandlerWithOptions(si, ChiServerOptions{})
+type GetRoutes200JSONResponse RouteNode
+
+func (response GetRoutes200JSONResponse) VisitGetRoutesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
 }
 
-type ChiServerOptions struct {
-	BaseURL          string
-	BaseRouter       chi.Router
-	Middlewares      []MiddlewareFunc
-	ErrorHandlerFunc func(w http.ResponseWriter, r *http.Request, err error)
+type TestRoutesRequestObject struct {
+	Body *TestRoutesJSONRequestBody
 }
 
-// HandlerFromMux creates http.Handler with routing matching OpenAPI spec based on the provided mux.
-func HandlerFromMux(si ServerInterface, r chi.Router) http.Handler {
-	return HandlerWithOptions(si, ChiServerOptions{
-		BaseRouter: r,
-	})
+type TestRoutesResponseObject interface {
+	VisitTestRoutesResponse(w http.ResponseWriter) error
 }
 
-func HandlerFromMuxWithBaseURL(si ServerInterface, r chi.Router, baseURL string) http.Handler {
-	return HandlerWithOptions(si, ChiServerOptions{
-		BaseURL:    baseURL,
-		BaseRouter: r,
-	})
+type TestRoutes200JSONResponse RouteTestResult
+
+func (response TestRoutes200JSONResponse) VisitTestRoutesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
 }
 
-// HandlerWithOptions creates http.Handler with additional options
-func HandlerWithOptions(si ServerInterface, options ChiServerOptions) http.Handler {
-	r := options.BaseRouter
+type TestRoutes400JSONResponse string
 
-	if r == nil {
-		r = chi.NewRouter()
-	}
-	if options.ErrorHandlerFunc == nil {
-		options.ErrorHandlerFunc = func(w http.ResponseWriter, r *http.Request, err error) {
-			http.Error(w, err.Error(), http.StatusBadRequest)
-		}
-	}
-	wrapper := ServerInterfaceWrapper{
-		Handler:            si,
-		HandlerMiddlewares: options.Middlewares,
-		ErrorHandlerFunc:   options.ErrorHandlerFunc,
-	}
+func (response TestRoutes400JSONResponse) VisitTestRoutesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
 
-	r.Group(func(r chi.Router) {
-		r.Get(options.BaseURL+"/alerts", wrapper.GetAlerts)
-	})
+	return json.NewEncoder(w).Encode(response)
+}
 
-	return r
+type TestRoutes404JSONResponse string
+
+func (response TestRoutes404JSONResponse) VisitTestRoutesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
 }
 
-type GetAlertsRequestObject struct {
-	Params GetAlertsParams
+type RenderTemplatesRequestObject struct {
+	Body *RenderTemplatesJSONRequestBody
 }
 
-type GetAlertsResponseObject interface {
-	VisitGetAlertsResponse(w http.ResponseWriter) error
+type RenderTemplatesResponseObject interface {
+	VisitRenderTemplatesResponse(w http.ResponseWriter) error
 }
 
-type GetAlerts200JSONResponse GettableAlerts
+type RenderTemplates200JSONResponse TemplateRender
 
-func (response GetAlerts200JSONResponse) VisitGetAlertsResponse(w http.ResponseWriter) error {
+func (response RenderTemplates200JSONResponse) VisitRenderTemplatesResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(200)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type GetAlerts400JSONResponse string
+type RenderTemplates400JSONResponse TemplateErrors
 
-func (response GetAlerts400JSONResponse) VisitGetAlertsResponse(w http.ResponseWriter) error {
+func (response RenderTemplates400JSONResponse) VisitRenderTemplatesResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(400)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type GetAlerts500JSONResponse string
+type RenderTemplates500JSONResponse string
 
-func (response GetAlerts500JSONResponse) VisitGetAlertsResponse(w http.ResponseWriter) error {
+func (response RenderTemplates500JSONResponse) VisitRenderTemplatesResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(500)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
+type GetTemplateStatusRequestObject struct {
+}
+
+type GetTemplateStatusResponseObject interface {
+	VisitGetTemplateStatusResponse(w http.ResponseWriter) error
+}
+
+type GetTemplateStatus200JSONResponse TemplateStatus
+
+func (response GetTemplateStatus200JSONResponse) VisitGetTemplateStatusResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
 // StrictServerInterface represents all server handlers.
 type StrictServerInterface interface {
 
 	// (GET /alerts)
 	GetAlerts(ctx context.Context, request GetAlertsRequestObject) (GetAlertsResponseObject, error)
+
+	// (GET /alerts/events)
+	GetAlertEvents(ctx context.Context, request GetAlertEventsRequestObject) (GetAlertEventsResponseObject, error)
+
+	// (GET /alerts/history)
+	GetAlertHistory(ctx context.Context, request GetAlertHistoryRequestObject) (GetAlertHistoryResponseObject, error)
+
+	// (GET /escalations)
+	GetEscalations(ctx context.Context, request GetEscalationsRequestObject) (GetEscalationsResponseObject, error)
+
+	// (GET /notifications/dryrun)
+	GetDryRunNotifications(ctx context.Context, request GetDryRunNotificationsRequestObject) (GetDryRunNotificationsResponseObject, error)
+
+	// (GET /routes)
+	GetRoutes(ctx context.Context, request GetRoutesRequestObject) (GetRoutesResponseObject, error)
+
+	// (POST /routes/test)
+	TestRoutes(ctx context.Context, request TestRoutesRequestObject) (TestRoutesResponseObject, error)
+
+	// (POST /templates/render)
+	RenderTemplates(ctx context.Context, request RenderTemplatesRequestObject) (RenderTemplatesResponseObject, error)
+
+	// (GET /templates/status)
+	GetTemplateStatus(ctx context.Context, request GetTemplateStatusRequestObject) (GetTemplateStatusResponseObject, error)
 }
 
 type StrictHandlerFunc = strictnethttp.StrictHTTPHandlerFunc
@@ -733,3 +2542,215 @@ func (sh *strictHandler) GetAlerts(w http.ResponseWriter, r *http.Request, param
 		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
 	}
 }
+
+// GetAlertEvents operation middleware
+func (sh *strictHandler) GetAlertEvents(w http.ResponseWriter, r *http.Request, params GetAlertEventsParams) {
+	var request GetAlertEventsRequestObject
+
+	request.Params = params
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetAlertEvents(ctx, request.(GetAlertEventsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetAlertEvents")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetAlertEventsResponseObject); ok {
+		if err := validResponse.VisitGetAlertEventsResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetAlertHistory operation middleware
+func (sh *strictHandler) GetAlertHistory(w http.ResponseWriter, r *http.Request, params GetAlertHistoryParams) {
+	var request GetAlertHistoryRequestObject
+
+	request.Params = params
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetAlertHistory(ctx, request.(GetAlertHistoryRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetAlertHistory")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetAlertHistoryResponseObject); ok {
+		if err := validResponse.VisitGetAlertHistoryResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetEscalations operation middleware
+func (sh *strictHandler) GetEscalations(w http.ResponseWriter, r *http.Request) {
+	var request GetEscalationsRequestObject
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetEscalations(ctx, request.(GetEscalationsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetEscalations")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetEscalationsResponseObject); ok {
+		if err := validResponse.VisitGetEscalationsResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetDryRunNotifications operation middleware
+func (sh *strictHandler) GetDryRunNotifications(w http.ResponseWriter, r *http.Request, params GetDryRunNotificationsParams) {
+	var request GetDryRunNotificationsRequestObject
+
+	request.Params = params
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetDryRunNotifications(ctx, request.(GetDryRunNotificationsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetDryRunNotifications")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetDryRunNotificationsResponseObject); ok {
+		if err := validResponse.VisitGetDryRunNotificationsResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetRoutes operation middleware
+func (sh *strictHandler) GetRoutes(w http.ResponseWriter, r *http.Request) {
+	var request GetRoutesRequestObject
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetRoutes(ctx, request.(GetRoutesRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetRoutes")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetRoutesResponseObject); ok {
+		if err := validResponse.VisitGetRoutesResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// TestRoutes operation middleware
+func (sh *strictHandler) TestRoutes(w http.ResponseWriter, r *http.Request) {
+	var request TestRoutesRequestObject
+
+	var body TestRoutesJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.TestRoutes(ctx, request.(TestRoutesRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "TestRoutes")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(TestRoutesResponseObject); ok {
+		if err := validResponse.VisitTestRoutesResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// RenderTemplates operation middleware
+func (sh *strictHandler) RenderTemplates(w http.ResponseWriter, r *http.Request) {
+	var request RenderTemplatesRequestObject
+
+	var body RenderTemplatesJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.RenderTemplates(ctx, request.(RenderTemplatesRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "RenderTemplates")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(RenderTemplatesResponseObject); ok {
+		if err := validResponse.VisitRenderTemplatesResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetTemplateStatus operation middleware
+func (sh *strictHandler) GetTemplateStatus(w http.ResponseWriter, r *http.Request) {
+	var request GetTemplateStatusRequestObject
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetTemplateStatus(ctx, request.(GetTemplateStatusRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetTemplateStatus")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetTemplateStatusResponseObject); ok {
+		if err := validResponse.VisitGetTemplateStatusResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
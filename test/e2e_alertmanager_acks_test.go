@@ -0,0 +1,146 @@
+package test
+
+import (
+	"context"
+	"log/slog"
+	"net/http"
+	"net/url"
+	"path/filepath"
+	"time"
+
+	"github.com/pgillich/micro-server/pkg/logger"
+	mw_client "github.com/pgillich/micro-server/pkg/middleware/client"
+	mw_client_model "github.com/pgillich/micro-server/pkg/middleware/client/model"
+	srv_utils "github.com/pgillich/micro-server/pkg/utils"
+
+	"github.com/pgillich/mimir-multitenant_alertmanager/configs"
+	"github.com/pgillich/mimir-multitenant_alertmanager/internal/alertmanager"
+	"github.com/pgillich/mimir-multitenant_alertmanager/internal/buildinfo"
+	srv_api "github.com/pgillich/mimir-multitenant_alertmanager/pkg/api/alertmanager"
+)
+
+func (s *AlertmanagerSuite) TestAcks() {
+	log := logger.GetLogger(buildinfo.BuildInfo.AppName(), slog.LevelDebug).With(logger.KeyTestCase, s.T().Name())
+	ackPath := filepath.Join(s.T().TempDir(), "acks.json")
+	serverConfig := &configs.ServerConfig{
+		Alerts: &configs.AlertsConfig{
+			AlertmanagerUrl: "http://localhost:8085/alertmanager/api/v2",
+			Tenants:         []string{"devops", "app-development"},
+			TenantLabel:     "tenant",
+			AckPath:         ackPath,
+			// the acknowledgements of the resolved alerts are dropped in the background
+			AckResolvePeriodSec: 1,
+		},
+	}
+	testConfig := &configs.TestConfig{
+		CaptureTransportMode: mw_client_model.CaptureTransportModeFake,
+		CaptureDir:           "../testdata/capture",
+		CaptureMatchers: []mw_client_model.CaptureMatcher{
+			mw_client.CaptureEqualRequestURLAndHeader(configs.HttpHeaderXscopeorgid),
+		},
+	}
+
+	server := runTestServer(s.T(), serverConfig, testConfig, []string{"multitenant-alertmanager"}, log)
+	defer server.Cancel()
+
+	testRootUrl, err := url.JoinPath(server.TestServer.URL, "/multitenant-alertmanager/api/v2")
+	s.NoError(err, "testRootUrl")
+	mimirClient, err := srv_api.NewClientWithResponses(
+		testRootUrl,
+		srv_api.WithHTTPClient(srv_utils.NewHttpClient()),
+	)
+	s.NoError(err, "srv_api.NewClientWithResponses")
+
+	clientCtx := logger.NewContext(context.Background(), log)
+	alertsResp, err := mimirClient.GetAlertsWithResponse(clientCtx, &srv_api.GetAlertsParams{})
+	s.NoError(err, "GetAlertsWithResponse")
+	s.NotEmpty(*alertsResp.JSON200, "alertsResp.JSON200")
+	fingerprint := (*alertsResp.JSON200)[0].Fingerprint
+
+	badResp, err := mimirClient.PostAckWithResponse(clientCtx, fingerprint, srv_api.PostableAck{})
+	s.NoError(err, "PostAckWithResponse")
+	s.Equal(http.StatusBadRequest, badResp.StatusCode(), "missing user")
+
+	comment := "I'm on it"
+	expiresAt := time.Now().Add(time.Hour).Truncate(time.Second)
+	ackResp, err := mimirClient.PostAckWithResponse(clientCtx, fingerprint,
+		srv_api.PostableAck{User: "jdoe", Comment: &comment, ExpiresAt: &expiresAt})
+	s.NoError(err, "PostAckWithResponse")
+	if !s.NotNil(ackResp.JSON200, "ackResp.JSON200") {
+		return
+	}
+	s.Equal("jdoe", ackResp.JSON200.User, "User")
+	s.Equal(comment, ackResp.JSON200.Comment, "Comment")
+
+	alertsResp, err = mimirClient.GetAlertsWithResponse(clientCtx, &srv_api.GetAlertsParams{})
+	s.NoError(err, "GetAlertsWithResponse")
+	for _, alert := range *alertsResp.JSON200 {
+		if alert.Fingerprint == fingerprint {
+			s.Equal("jdoe", alert.Annotations[configs.AckedByAnnotation], "acked_by")
+			s.Equal(ackResp.JSON200.AckedAt.Format(time.RFC3339), alert.Annotations[configs.AckedAtAnnotation], "acked_at")
+		} else {
+			s.NotContains(alert.Annotations, configs.AckedByAnnotation, "acked_by")
+		}
+	}
+	groupsResp, err := mimirClient.GetAlertGroupsWithResponse(clientCtx, &srv_api.GetAlertGroupsParams{})
+	s.NoError(err, "GetAlertGroupsWithResponse")
+	acked := 0
+	for _, alertGroup := range *groupsResp.JSON200 {
+		for _, alert := range alertGroup.Alerts {
+			if alert.Fingerprint == fingerprint {
+				s.Equal("jdoe", alert.Annotations[configs.AckedByAnnotation], "acked_by in group")
+				acked++
+			} else {
+				s.NotContains(alert.Annotations, configs.AckedByAnnotation, "acked_by in group")
+			}
+		}
+	}
+	s.NotZero(acked, "acked alerts in groups")
+
+	resolvedResp, err := mimirClient.PostAckWithResponse(clientCtx, "1234", srv_api.PostableAck{User: "jdoe"})
+	s.NoError(err, "PostAckWithResponse")
+	s.Equal(http.StatusOK, resolvedResp.StatusCode(), "ack of resolved alert")
+	_, err = mimirClient.GetAlertsWithResponse(clientCtx, &srv_api.GetAlertsParams{})
+	s.NoError(err, "GetAlertsWithResponse")
+	listResp, err := mimirClient.GetAcksWithResponse(clientCtx)
+	s.NoError(err, "GetAcksWithResponse")
+	s.Len(*listResp.JSON200, 2, "acks after GetAlerts")
+	s.Eventually(func() bool {
+		listResp, err = mimirClient.GetAcksWithResponse(clientCtx)
+		return err == nil && len(*listResp.JSON200) == 1
+	}, 5*time.Second, 100*time.Millisecond, "acks after resolve")
+	s.Equal(fingerprint, (*listResp.JSON200)[0].Fingerprint, "acked fingerprint after resolve")
+
+	acks, err := alertmanager.NewAckStore(ackPath, time.Now)
+	s.NoError(err, "NewAckStore")
+	s.Len(acks.List(), 1, "persisted acks")
+	acks, err = alertmanager.NewAckStore(ackPath, func() time.Time { return expiresAt })
+	s.NoError(err, "NewAckStore expired")
+	s.Empty(acks.List(), "expired acks")
+
+	deleteResp, err := mimirClient.DeleteAckWithResponse(clientCtx, fingerprint)
+	s.NoError(err, "DeleteAckWithResponse")
+	s.Equal(http.StatusOK, deleteResp.StatusCode(), "DeleteAck")
+	deleteResp, err = mimirClient.DeleteAckWithResponse(clientCtx, fingerprint)
+	s.NoError(err, "DeleteAckWithResponse")
+	s.Equal(http.StatusNotFound, deleteResp.StatusCode(), "DeleteAck again")
+	listResp, err = mimirClient.GetAcksWithResponse(clientCtx)
+	s.NoError(err, "GetAcksWithResponse")
+	s.Empty(*listResp.JSON200, "GetAcks")
+
+	ackedAt := time.Now()
+	acks, err = alertmanager.NewAckStore("", func() time.Time { return ackedAt })
+	s.NoError(err, "NewAckStore in memory")
+	_, err = acks.Ack(fingerprint, srv_api.PostableAck{User: "jdoe"})
+	s.NoError(err, "Ack")
+	s.NoError(acks.Resolve([]srv_api.GettableAlert{{Fingerprint: fingerprint, StartsAt: ackedAt.Add(-time.Hour)}}), "Resolve")
+	s.Len(acks.List(), 1, "acks of firing alert")
+	s.NoError(acks.Resolve([]srv_api.GettableAlert{{Fingerprint: fingerprint, StartsAt: ackedAt.Add(time.Minute)}}), "Resolve")
+	s.Empty(acks.List(), "acks of fired again alert")
+
+	acks, err = alertmanager.NewAckStore(filepath.Join(s.T().TempDir(), "missing", "acks.json"), time.Now)
+	s.NoError(err, "NewAckStore unwritable")
+	_, err = acks.Ack(fingerprint, srv_api.PostableAck{User: "jdoe"})
+	s.ErrorIs(err, alertmanager.ErrAckStore, "Ack unwritable")
+	s.Empty(acks.List(), "acks after failed save")
+}
@@ -0,0 +1,153 @@
+package test
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"log/slog"
+	"net/http"
+	"net/url"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/pgillich/micro-server/pkg/logger"
+	mw_client "github.com/pgillich/micro-server/pkg/middleware/client"
+	mw_client_model "github.com/pgillich/micro-server/pkg/middleware/client/model"
+	srv_utils "github.com/pgillich/micro-server/pkg/utils"
+
+	"github.com/pgillich/mimir-multitenant_alertmanager/configs"
+	"github.com/pgillich/mimir-multitenant_alertmanager/internal/actionlink"
+	"github.com/pgillich/mimir-multitenant_alertmanager/internal/buildinfo"
+	srv_api "github.com/pgillich/mimir-multitenant_alertmanager/pkg/api/alertmanager"
+)
+
+func (s *AlertmanagerSuite) TestActionLinks() {
+	log := logger.GetLogger(buildinfo.BuildInfo.AppName(), slog.LevelDebug).With(logger.KeyTestCase, s.T().Name())
+	const secret = "action-link-secret"
+	serverConfig := &configs.ServerConfig{
+		Alerts: &configs.AlertsConfig{
+			AlertmanagerUrl:  "http://localhost:8085/alertmanager/api/v2",
+			Tenants:          []string{"devops", "app-development"},
+			TenantLabel:      "tenant",
+			ActionLinkSecret: secret,
+			AckPath:          filepath.Join(s.T().TempDir(), "acks.json"),
+		},
+	}
+	testConfig := &configs.TestConfig{
+		CaptureTransportMode: mw_client_model.CaptureTransportModeFake,
+		CaptureDir:           "../testdata/capture",
+		CaptureMatchers: []mw_client_model.CaptureMatcher{
+			mw_client.CaptureEqualRequestURLAndHeader(configs.HttpHeaderXscopeorgid),
+		},
+	}
+
+	server := runTestServer(s.T(), serverConfig, testConfig, []string{"multitenant-alertmanager"}, log)
+	defer server.Cancel()
+
+	testRootUrl, err := url.JoinPath(server.TestServer.URL, "/multitenant-alertmanager/api/v2")
+	s.NoError(err, "testRootUrl")
+	mimirClient, err := srv_api.NewClientWithResponses(
+		testRootUrl,
+		srv_api.WithHTTPClient(srv_utils.NewHttpClient()),
+	)
+	s.NoError(err, "srv_api.NewClientWithResponses")
+
+	clientCtx := logger.NewContext(context.Background(), log)
+	alertsResp, err := mimirClient.GetAlertsWithResponse(clientCtx, &srv_api.GetAlertsParams{})
+	s.NoError(err, "GetAlertsWithResponse")
+	s.NotEmpty(*alertsResp.JSON200, "alertsResp.JSON200")
+	alert := (*alertsResp.JSON200)[0]
+	expires := time.Now().Add(time.Hour)
+	httpClient := &http.Client{CheckRedirect: func(*http.Request, []*http.Request) error { return http.ErrUseLastResponse }}
+	doAction := func(method string, link actionlink.Link, tamper bool) (*http.Response, string, srv_api.ActionResult) {
+		actionURL, err := actionlink.URL(server.TestServer.URL, secret, link)
+		s.NoError(err, "actionlink.URL")
+		if tamper {
+			actionURL = strings.Replace(actionURL, "fingerprint=", "fingerprint=0", 1)
+		}
+		req, err := http.NewRequestWithContext(clientCtx, method, actionURL, nil)
+		s.NoError(err, "NewRequestWithContext")
+		resp, err := httpClient.Do(req)
+		s.NoError(err, "Do")
+		defer resp.Body.Close()
+		body, err := io.ReadAll(resp.Body)
+		s.NoError(err, "ReadAll")
+		result := srv_api.ActionResult{}
+		if resp.StatusCode == http.StatusOK && method == http.MethodPost {
+			s.NoError(json.Unmarshal(body, &result), "ActionResult")
+		}
+
+		return resp, string(body), result
+	}
+	link := func(action string, duration string, expires time.Time) actionlink.Link {
+		return actionlink.Link{
+			Action: action, Tenant: alert.Labels["tenant"], Fingerprint: alert.Fingerprint, Duration: duration, Expires: expires,
+		}
+	}
+
+	resp, page, _ := doAction(http.MethodGet, link(actionlink.ActionSilence, "2h", expires), false)
+	s.Equal(http.StatusOK, resp.StatusCode, "silence page")
+	s.Equal("text/html", resp.Header.Get("Content-Type"), "silence page")
+	s.Contains(page, `<form method="post">`, "silence page")
+	s.Contains(page, "for 2h?", "silence page")
+	resp, _, result := doAction(http.MethodPost, link(actionlink.ActionSilence, "2h", expires), false)
+	s.Equal(http.StatusOK, resp.StatusCode, "silence")
+	if s.NotNil(result.SilenceID, "SilenceID") {
+		s.NotEmpty(*result.SilenceID, "SilenceID")
+	}
+
+	resp, page, _ = doAction(http.MethodGet, link(actionlink.ActionAck, "", expires), false)
+	s.Equal(http.StatusOK, resp.StatusCode, "ack page")
+	s.Contains(page, "Acknowledge the alert", "ack page")
+	acksResp, err := mimirClient.GetAcksWithResponse(clientCtx)
+	s.NoError(err, "GetAcksWithResponse")
+	s.Empty(*acksResp.JSON200, "acks after ack page")
+	for range 2 {
+		resp, _, _ = doAction(http.MethodPost, link(actionlink.ActionAck, "", expires), false)
+		s.Equal(http.StatusOK, resp.StatusCode, "ack")
+	}
+	acksResp, err = mimirClient.GetAcksWithResponse(clientCtx)
+	s.NoError(err, "GetAcksWithResponse")
+	if s.Len(*acksResp.JSON200, 1, "acks") {
+		s.Equal(actionlink.User, (*acksResp.JSON200)[0].User, "User")
+	}
+	deleteResp, err := mimirClient.DeleteAckWithResponse(clientCtx, alert.Fingerprint)
+	s.NoError(err, "DeleteAckWithResponse")
+	s.Equal(http.StatusOK, deleteResp.StatusCode(), "DeleteAck")
+
+	resp, _, _ = doAction(http.MethodGet, link(actionlink.ActionView, "", expires), false)
+	s.Equal(http.StatusSeeOther, resp.StatusCode, "view")
+	location, err := resp.Location()
+	s.NoError(err, "Location")
+	s.Equal("/ui/alert.html?fingerprint="+alert.Fingerprint, location.RequestURI(), "Location")
+
+	resp, _, _ = doAction(http.MethodPost, link(actionlink.ActionView, "", expires), false)
+	s.Equal(http.StatusBadRequest, resp.StatusCode, "view post")
+
+	resp, _, _ = doAction(http.MethodGet, link(actionlink.ActionSilence, "2h", expires), true)
+	s.Equal(http.StatusForbidden, resp.StatusCode, "tampered page")
+	resp, _, _ = doAction(http.MethodPost, link(actionlink.ActionSilence, "2h", expires), true)
+	s.Equal(http.StatusForbidden, resp.StatusCode, "tampered")
+	resp, _, _ = doAction(http.MethodPost, link(actionlink.ActionSilence, "2h", time.Now().Add(-time.Minute)), false)
+	s.Equal(http.StatusForbidden, resp.StatusCode, "expired")
+	resp, _, _ = doAction(http.MethodPost, link(actionlink.ActionSilence, "forever", expires), false)
+	s.Equal(http.StatusBadRequest, resp.StatusCode, "invalid duration")
+
+	equal := true
+	silence := srv_api.PostableSilence{
+		Matchers:  srv_api.Matchers{{Name: "alertname", Value: alert.Labels["alertname"], IsEqual: &equal}},
+		StartsAt:  time.Now(),
+		EndsAt:    time.Now().Add(time.Hour),
+		CreatedBy: "jdoe",
+	}
+	silenceResp, err := mimirClient.PostSilencesWithResponse(clientCtx, silence)
+	s.NoError(err, "PostSilencesWithResponse")
+	s.Equal(http.StatusBadRequest, silenceResp.StatusCode(), "missing tenant matcher")
+	silence.Matchers = append(silence.Matchers, srv_api.Matcher{Name: "tenant", Value: "app-development", IsEqual: &equal})
+	silenceResp, err = mimirClient.PostSilencesWithResponse(clientCtx, silence)
+	s.NoError(err, "PostSilencesWithResponse")
+	if s.NotNil(silenceResp.JSON200, "silenceResp.JSON200") {
+		s.Equal("7a8b9c0d-1e2f-4a3b-8c5d-6e7f8a9b0c1d", *silenceResp.JSON200.SilenceID, "SilenceID")
+	}
+}
@@ -0,0 +1,88 @@
+package test
+
+import (
+	"context"
+	"log/slog"
+	"net/http"
+	"net/url"
+
+	"github.com/pgillich/micro-server/pkg/logger"
+	mw_client "github.com/pgillich/micro-server/pkg/middleware/client"
+	mw_client_model "github.com/pgillich/micro-server/pkg/middleware/client/model"
+	srv_utils "github.com/pgillich/micro-server/pkg/utils"
+	srv_api "github.com/pgillich/mimir-multitenant_alertmanager/pkg/api/alertmanager"
+
+	"github.com/pgillich/mimir-multitenant_alertmanager/configs"
+	"github.com/pgillich/mimir-multitenant_alertmanager/internal/buildinfo"
+)
+
+func (s *AlertmanagerSuite) TestAlertsPartial() {
+	log := logger.GetLogger(buildinfo.BuildInfo.AppName(), slog.LevelDebug).With(logger.KeyTestCase, s.T().Name())
+	serverConfig := &configs.ServerConfig{
+		Alerts: &configs.AlertsConfig{
+			AlertmanagerUrl: "http://localhost:8085/alertmanager/api/v2",
+			Tenants:         []string{"devops", "uncaptured", "app-development"},
+			TenantLabel:     "tenant",
+		},
+	}
+	testConfig := &configs.TestConfig{
+		CaptureTransportMode: mw_client_model.CaptureTransportModeFake,
+		CaptureDir:           "../testdata/capture",
+		CaptureMatchers: []mw_client_model.CaptureMatcher{
+			mw_client.CaptureEqualRequestURLAndHeader(configs.HttpHeaderXscopeorgid),
+		},
+	}
+
+	server := runTestServer(s.T(), serverConfig, testConfig, []string{"multitenant-alertmanager"}, log)
+	defer server.Cancel()
+
+	testRootUrl, err := url.JoinPath(server.TestServer.URL, "/multitenant-alertmanager/api/v2")
+	s.NoError(err, "testRootUrl")
+	mimirClient, err := srv_api.NewClientWithResponses(
+		testRootUrl,
+		srv_api.WithHTTPClient(srv_utils.NewHttpClient()),
+	)
+	s.NoError(err, "srv_api.NewClientWithResponses")
+	clientCtx := logger.NewContext(context.Background(), log)
+
+	clientResp, err := mimirClient.GetAlertsWithResponse(clientCtx, &srv_api.GetAlertsParams{})
+	s.NoError(err, "GetAlertsWithResponse")
+	s.Equal(http.StatusInternalServerError, clientResp.StatusCode(), "without partial response")
+
+	clientResp, err = mimirClient.GetAlertsWithResponse(clientCtx, &srv_api.GetAlertsParams{},
+		func(_ context.Context, req *http.Request) error {
+			req.Header.Set(configs.HttpHeaderPartialResponse, "true")
+			return nil
+		})
+	s.NoError(err, "GetAlertsWithResponse")
+	s.Equal(http.StatusOK, clientResp.StatusCode(), "partial response")
+	s.Equal("uncaptured", clientResp.HTTPResponse.Header.Get(configs.HttpHeaderFailedTenants), "failed tenants")
+	if s.NotNil(clientResp.JSON200, "JSON200") {
+		tenants := map[string]bool{}
+		for _, alert := range *clientResp.JSON200 {
+			tenants[alert.Labels["tenant"]] = true
+		}
+		s.Equal(map[string]bool{"devops": true, "app-development": true}, tenants, "tenants")
+
+		for _, alert := range *clientResp.JSON200 {
+			alertResp, err := mimirClient.GetTenantAlertWithResponse(clientCtx, alert.Fingerprint, &srv_api.GetTenantAlertParams{})
+			s.NoError(err, "GetTenantAlertWithResponse")
+			if s.NotNil(alertResp.JSON200, "tenant alert without failed tenant") {
+				s.Equal(alert.Labels["tenant"], alertResp.JSON200.Tenant, "tenant")
+			}
+		}
+	}
+
+	alertResp, err := mimirClient.GetTenantAlertWithResponse(clientCtx, "0", &srv_api.GetTenantAlertParams{})
+	s.NoError(err, "GetTenantAlertWithResponse")
+	s.Equal(http.StatusInternalServerError, alertResp.StatusCode(), "not found with failed tenant")
+	s.Equal("uncaptured", alertResp.HTTPResponse.Header.Get(configs.HttpHeaderFailedTenants), "failed tenants")
+	if s.NotNil(alertResp.JSON500, "JSON500") {
+		s.Contains(*alertResp.JSON500, "uncaptured", "failed tenants in error")
+	}
+
+	uncaptured := "uncaptured"
+	alertResp, err = mimirClient.GetTenantAlertWithResponse(clientCtx, "0", &srv_api.GetTenantAlertParams{Tenant: &uncaptured})
+	s.NoError(err, "GetTenantAlertWithResponse")
+	s.Equal(http.StatusInternalServerError, alertResp.StatusCode(), "failed tenant")
+}
@@ -3,22 +3,29 @@ package test
 import (
 	"context"
 	"log/slog"
+	"net/http"
 	"net/url"
+	"os"
+	"strings"
 	"testing"
+	"time"
 
 	yaml "github.com/goccy/go-yaml"
+	am_config "github.com/prometheus/alertmanager/config"
 	"github.com/stretchr/testify/suite"
 
 	"github.com/pgillich/micro-server/pkg/logger"
 	mw_client "github.com/pgillich/micro-server/pkg/middleware/client"
 	mw_client_model "github.com/pgillich/micro-server/pkg/middleware/client/model"
-	srv_testutil "github.com/pgillich/micro-server/pkg/testutil"
 	srv_utils "github.com/pgillich/micro-server/pkg/utils"
 	srv_api "github.com/pgillich/mimir-multitenant_alertmanager/pkg/api/alertmanager"
+	config_api "github.com/pgillich/mimir-multitenant_alertmanager/pkg/api/mimir_config"
+	notifyer_api "github.com/pgillich/mimir-multitenant_alertmanager/pkg/api/notifyer"
 
 	"github.com/pgillich/mimir-multitenant_alertmanager/configs"
-	_ "github.com/pgillich/mimir-multitenant_alertmanager/internal/alertmanager"
+	"github.com/pgillich/mimir-multitenant_alertmanager/internal/alertmanager"
 	"github.com/pgillich/mimir-multitenant_alertmanager/internal/buildinfo"
+	notifyer "github.com/pgillich/mimir-multitenant_alertmanager/internal/notifyer"
 	// "github.com/pgillich/mimir-multitenant_alertmanager/internal/tracing"
 )
 
@@ -48,8 +55,7 @@ func (s *AlertmanagerSuite) TestAlerts() {
 		},
 	}
 
-	server := srv_testutil.RunTestServerCmd(s.T(), "services",
-		buildinfo.BuildInfo, serverConfig, testConfig, []string{"multitenant-alertmanager"}, log)
+	server := runTestServer(s.T(), serverConfig, testConfig, []string{"multitenant-alertmanager"}, log)
 	defer server.Cancel()
 
 	testRootUrl, err := url.JoinPath(server.TestServer.URL, "/multitenant-alertmanager/api/v2")
@@ -91,8 +97,7 @@ func (s *AlertmanagerSuite) TestAlertGroups() {
 		},
 	}
 
-	server := srv_testutil.RunTestServerCmd(s.T(), "services",
-		buildinfo.BuildInfo, serverConfig, testConfig, []string{"multitenant-alertmanager"}, log)
+	server := runTestServer(s.T(), serverConfig, testConfig, []string{"multitenant-alertmanager"}, log)
 	defer server.Cancel()
 
 	testRootUrl, err := url.JoinPath(server.TestServer.URL, "/multitenant-alertmanager/api/v2")
@@ -134,8 +139,7 @@ func (s *AlertmanagerSuite) TestSilences() {
 		},
 	}
 
-	server := srv_testutil.RunTestServerCmd(s.T(), "services",
-		buildinfo.BuildInfo, serverConfig, testConfig, []string{"multitenant-alertmanager"}, log)
+	server := runTestServer(s.T(), serverConfig, testConfig, []string{"multitenant-alertmanager"}, log)
 	defer server.Cancel()
 
 	testRootUrl, err := url.JoinPath(server.TestServer.URL, "/alertmanager/api/v2")
@@ -158,3 +162,343 @@ func (s *AlertmanagerSuite) TestSilences() {
 
 	//time.Sleep(1000 * time.Second)
 }
+
+func (s *AlertmanagerSuite) TestTenantMeta() {
+	log := logger.GetLogger(buildinfo.BuildInfo.AppName(), slog.LevelDebug).With(logger.KeyTestCase, s.T().Name())
+	serverConfig := &configs.ServerConfig{
+		Alerts: &configs.AlertsConfig{
+			AlertmanagerUrl: "http://localhost:8085/alertmanager/api/v2",
+			Tenants:         []string{"devops", "app-development"},
+			TenantLabel:     "tenant",
+			TenantMeta: &configs.TenantMetaConfig{
+				Tenants: map[string]map[string]string{
+					"devops": {"team": "DevOps", "owner": "devops@example.com", "cluster": "devops-cluster"},
+				},
+				Labels:      map[string]string{"team": "team", "cluster": "cluster"},
+				Annotations: map[string]string{"owner": "owner"},
+			},
+		},
+	}
+	testConfig := &configs.TestConfig{
+		CaptureTransportMode: mw_client_model.CaptureTransportModeFake,
+		CaptureDir:           "../testdata/capture",
+		CaptureMatchers: []mw_client_model.CaptureMatcher{
+			mw_client.CaptureEqualRequestURLAndHeader(configs.HttpHeaderXscopeorgid),
+		},
+	}
+
+	server := runTestServer(s.T(), serverConfig, testConfig, []string{"multitenant-alertmanager"}, log)
+	defer server.Cancel()
+
+	testRootUrl, err := url.JoinPath(server.TestServer.URL, "/multitenant-alertmanager/api/v2")
+	s.NoError(err, "testRootUrl")
+	mimirClient, err := srv_api.NewClientWithResponses(
+		testRootUrl,
+		srv_api.WithHTTPClient(srv_utils.NewHttpClient()),
+	)
+	s.NoError(err, "srv_api.NewClientWithResponses")
+
+	clientCtx := logger.NewContext(context.Background(), log)
+	clientResp, err := mimirClient.GetAlertsWithResponse(clientCtx, &srv_api.GetAlertsParams{})
+	s.NoError(err, "GetAlertsWithResponse")
+	s.NotNil(clientResp.JSON200, "clientResp.JSON200")
+	s.NotEmpty(*clientResp.JSON200, "clientResp.JSON200")
+
+	for _, alert := range *clientResp.JSON200 {
+		if alert.Labels["tenant"] == "devops" {
+			s.Equal("DevOps", alert.Labels["team"], "team label")
+			s.Equal("devops@example.com", alert.Annotations["owner"], "owner annotation")
+			s.True(strings.HasPrefix(alert.Labels["cluster"], "org-dev-aks-"), "existing cluster label kept")
+		} else {
+			s.NotContains(alert.Labels, "team", "team label")
+		}
+	}
+
+	labels := srv_api.LabelSet{"cluster": "upstream"}
+	serverConfig.Alerts.TenantMeta.Override = true
+	alertmanager.SetTenantMeta(log, serverConfig.Alerts, "devops", labels, srv_api.LabelSet{})
+	s.Equal("devops-cluster", labels["cluster"], "overridden cluster label")
+
+	devopsAlerts := notifyer_api.GettableAlerts{}
+	for _, alert := range *clientResp.JSON200 {
+		if alert.Labels["tenant"] == "devops" {
+			devopsAlerts = append(devopsAlerts, notifyer_api.GettableAlert{
+				Labels: notifyer_api.LabelSet(alert.Labels), EndsAt: time.Now().Add(time.Hour),
+			})
+		}
+	}
+	s.NotEmpty(devopsAlerts, "devops alerts")
+	subject := `{{ tenantMeta "devops" "owner" }}`
+	text := `{{ range .Alerts }}{{ tenantMeta .Labels.tenant "team" }};{{ end }}`
+	html := `{{ tenantMeta "unknown" "team" }}`
+	render, templateErrors, err := notifyer.RenderTemplates(clientCtx, serverConfig.Alerts, &configs.NotifyerConfig{
+		ExternalURL: "http://ExternalURL",
+		Route:       &am_config.Route{Receiver: "email"},
+	}, notifyer_api.TemplateRenderRequest{Subject: &subject, Text: &text, Html: &html}, devopsAlerts)
+	s.NoError(err, "RenderTemplates")
+	s.Empty(templateErrors, "templateErrors")
+	if s.NotNil(render, "render") {
+		s.Equal("devops@example.com", render.Subject, "tenantMeta in Subject")
+		s.Equal(strings.Repeat("DevOps;", len(devopsAlerts)), render.Text, "tenantMeta in Text")
+		s.Empty(render.Html, "tenantMeta of unknown tenant")
+	}
+}
+
+func (s *AlertmanagerSuite) TestTenantAlert() {
+	log := logger.GetLogger(buildinfo.BuildInfo.AppName(), slog.LevelDebug).With(logger.KeyTestCase, s.T().Name())
+	serverConfig := &configs.ServerConfig{
+		Alerts: &configs.AlertsConfig{
+			AlertmanagerUrl: "http://localhost:8085/alertmanager/api/v2",
+			Tenants:         []string{"devops", "app-development"},
+			TenantLabel:     "tenant",
+		},
+	}
+	testConfig := &configs.TestConfig{
+		CaptureTransportMode: mw_client_model.CaptureTransportModeFake,
+		CaptureDir:           "../testdata/capture",
+		CaptureMatchers: []mw_client_model.CaptureMatcher{
+			mw_client.CaptureEqualRequestURLAndHeader(configs.HttpHeaderXscopeorgid),
+		},
+	}
+
+	server := runTestServer(s.T(), serverConfig, testConfig, []string{"multitenant-alertmanager"}, log)
+	defer server.Cancel()
+
+	testRootUrl, err := url.JoinPath(server.TestServer.URL, "/multitenant-alertmanager/api/v2")
+	s.NoError(err, "testRootUrl")
+	mimirClient, err := srv_api.NewClientWithResponses(
+		testRootUrl,
+		srv_api.WithHTTPClient(srv_utils.NewHttpClient()),
+	)
+	s.NoError(err, "srv_api.NewClientWithResponses")
+
+	clientCtx := logger.NewContext(context.Background(), log)
+	alertsResp, err := mimirClient.GetAlertsWithResponse(clientCtx, &srv_api.GetAlertsParams{})
+	s.NoError(err, "GetAlertsWithResponse")
+	s.NotEmpty(*alertsResp.JSON200, "alertsResp.JSON200")
+	alert := (*alertsResp.JSON200)[0]
+
+	clientResp, err := mimirClient.GetTenantAlertWithResponse(clientCtx, alert.Fingerprint, &srv_api.GetTenantAlertParams{})
+	s.NoError(err, "GetTenantAlertWithResponse")
+	s.NotNil(clientResp.JSON200, "clientResp.JSON200")
+	s.Equal(alert.Labels["tenant"], clientResp.JSON200.Tenant, "tenant")
+	s.Equal(alert.Fingerprint, clientResp.JSON200.Fingerprint, "fingerprint")
+
+	tenant := clientResp.JSON200.Tenant
+	upstreamResp, err := mimirClient.GetTenantAlertWithResponse(clientCtx, clientResp.JSON200.UpstreamFingerprint,
+		&srv_api.GetTenantAlertParams{Tenant: &tenant})
+	s.NoError(err, "GetTenantAlertWithResponse")
+	s.NotNil(upstreamResp.JSON200, "upstreamResp.JSON200")
+	s.Equal(alert.Fingerprint, upstreamResp.JSON200.Fingerprint, "fingerprint")
+
+	notFoundResp, err := mimirClient.GetTenantAlertWithResponse(clientCtx, "0", &srv_api.GetTenantAlertParams{})
+	s.NoError(err, "GetTenantAlertWithResponse")
+	s.Equal(http.StatusNotFound, notFoundResp.StatusCode(), "not found")
+
+	unknownTenant := "unknown"
+	unknownResp, err := mimirClient.GetTenantAlertWithResponse(clientCtx, alert.Fingerprint,
+		&srv_api.GetTenantAlertParams{Tenant: &unknownTenant})
+	s.NoError(err, "GetTenantAlertWithResponse")
+	s.Equal(http.StatusBadRequest, unknownResp.StatusCode(), "unknown tenant")
+}
+
+func (s *AlertmanagerSuite) TestRules() {
+	log := logger.GetLogger(buildinfo.BuildInfo.AppName(), slog.LevelDebug).With(logger.KeyTestCase, s.T().Name())
+	serverConfig := &configs.ServerConfig{
+		Alerts: &configs.AlertsConfig{
+			AlertmanagerUrl: "http://localhost:8085/alertmanager/api/v2",
+			Tenants:         []string{"devops", "app-development"},
+			TenantLabel:     "tenant",
+		},
+	}
+	testConfig := &configs.TestConfig{
+		CaptureTransportMode: mw_client_model.CaptureTransportModeFake,
+		CaptureDir:           "../testdata/capture",
+		CaptureMatchers: []mw_client_model.CaptureMatcher{
+			mw_client.CaptureEqualRequestURLAndHeader(configs.HttpHeaderXscopeorgid),
+		},
+	}
+
+	server := runTestServer(s.T(), serverConfig, testConfig, []string{"multitenant-alertmanager"}, log)
+	defer server.Cancel()
+
+	testRootUrl, err := url.JoinPath(server.TestServer.URL, "/multitenant-alertmanager/api/v2")
+	s.NoError(err, "testRootUrl")
+	mimirClient, err := srv_api.NewClientWithResponses(
+		testRootUrl,
+		srv_api.WithHTTPClient(srv_utils.NewHttpClient()),
+	)
+	s.NoError(err, "srv_api.NewClientWithResponses")
+
+	clientCtx := logger.NewContext(context.Background(), log)
+	withAlerts := true
+	clientResp, err := mimirClient.GetRulesWithResponse(clientCtx, &srv_api.GetRulesParams{Alerts: &withAlerts})
+	s.NoError(err, "GetRulesWithResponse")
+	s.NotNil(clientResp.JSON200, "clientResp.JSON200")
+
+	bodyYaml, err := yaml.Marshal(clientResp.JSON200)
+	s.NoError(err, "clientResp.JSON200")
+	s.T().Logf("Client Resp\n%s", string(bodyYaml))
+
+	rules := map[string]srv_api.TenantRule{}
+	for _, group := range *clientResp.JSON200 {
+		for _, rule := range group.Rules {
+			s.Equal(group.Tenant, rule.Labels["tenant"], "tenant label")
+			rules[group.Tenant+"/"+rule.Name] = rule
+		}
+	}
+	s.Len(rules, 5, "rules")
+
+	cpuRule := rules["devops/KubeContainerCPUHigh"]
+	s.NotNil(cpuRule.Alerts, "cpuRule.Alerts")
+	s.Len(*cpuRule.Alerts, 1, "cpuRule.Alerts")
+	s.Equal("kubernetes-resources", (*cpuRule.Alerts)[0].Annotations["rule_group"], "rule_group")
+	s.Equal(cpuRule.Query, (*cpuRule.Alerts)[0].Annotations["rule_expr"], "rule_expr")
+
+	unhealthyRule := rules["devops/ArgocdServiceUnhealthy"]
+	s.NotNil(unhealthyRule.Alerts, "unhealthyRule.Alerts")
+	s.Empty(*unhealthyRule.Alerts, "unhealthyRule.Alerts")
+
+	s.Nil(rules["devops/container:cpu_usage:rate5m"].Alerts, "recording rule alerts")
+
+	devRule := rules["app-development/Service Unhealthy (Dev)"]
+	s.NotNil(devRule.Alerts, "devRule.Alerts")
+	s.Len(*devRule.Alerts, 1, "devRule.Alerts")
+}
+
+func (s *AlertmanagerSuite) TestTenantConfigs() {
+	log := logger.GetLogger(buildinfo.BuildInfo.AppName(), slog.LevelDebug).With(logger.KeyTestCase, s.T().Name())
+	serverConfig := &configs.ServerConfig{
+		Alerts: &configs.AlertsConfig{
+			AlertmanagerUrl: "http://localhost:8085/alertmanager/api/v2",
+			ConfigBaseline:  "../testdata/mimir_config/baseline.yaml",
+			Tenants:         []string{"devops", "app-development"},
+			TenantLabel:     "tenant",
+		},
+	}
+	testConfig := &configs.TestConfig{
+		CaptureTransportMode: mw_client_model.CaptureTransportModeFake,
+		CaptureDir:           "../testdata/capture",
+		CaptureMatchers: []mw_client_model.CaptureMatcher{
+			mw_client.CaptureEqualRequestURLAndHeader(configs.HttpHeaderXscopeorgid),
+		},
+	}
+
+	server := runTestServer(s.T(), serverConfig, testConfig, []string{"multitenant-alertmanager"}, log)
+	defer server.Cancel()
+
+	testRootUrl, err := url.JoinPath(server.TestServer.URL, "/multitenant-alertmanager/api/v2")
+	s.NoError(err, "testRootUrl")
+	mimirClient, err := srv_api.NewClientWithResponses(
+		testRootUrl,
+		srv_api.WithHTTPClient(srv_utils.NewHttpClient()),
+	)
+	s.NoError(err, "srv_api.NewClientWithResponses")
+
+	clientCtx := logger.NewContext(context.Background(), log)
+	clientResp, err := mimirClient.GetTenantConfigsWithResponse(clientCtx, &srv_api.GetTenantConfigsParams{})
+	s.NoError(err, "GetTenantConfigsWithResponse")
+	s.NotNil(clientResp.JSON200, "clientResp.JSON200")
+	s.Len(*clientResp.JSON200, 2, "tenants")
+
+	bodyYaml, err := yaml.Marshal(clientResp.JSON200)
+	s.NoError(err, "clientResp.JSON200")
+	s.T().Logf("Client Resp\n%s", string(bodyYaml))
+
+	devops := (*clientResp.JSON200)[0]
+	s.Equal("devops", devops.Tenant, "tenant")
+	s.True(devops.Found, "found")
+	s.Equal([]string{"devops.tmpl"}, devops.TemplateFiles, "templateFiles")
+	s.Equal(srv_api.ConfigFindings{{
+		Severity: srv_api.ConfigFindingWarning,
+		Message:  `receiver "old-webhook" is not used by any route`,
+	}}, devops.Findings, "findings")
+	s.NotNil(devops.AlertmanagerConfig, "alertmanagerConfig")
+	s.NotNil(devops.Diff, "diff")
+	s.Contains(*devops.Diff, "+  receiver: devops-email", "diff")
+
+	appDev := (*clientResp.JSON200)[1]
+	s.Equal("app-development", appDev.Tenant, "tenant")
+	s.Nil(appDev.AlertmanagerConfig, "alertmanagerConfig")
+	s.Nil(appDev.Diff, "diff")
+	s.Len(appDev.Findings, 1, "findings")
+	s.Equal(srv_api.ConfigFindingError, appDev.Findings[0].Severity, "severity")
+	s.Contains(appDev.Findings[0].Message, `undefined receiver "app-team"`, "message")
+
+	unknownTenants := []string{"unknown"}
+	unknownResp, err := mimirClient.GetTenantConfigsWithResponse(clientCtx, &srv_api.GetTenantConfigsParams{Tenant: &unknownTenants})
+	s.NoError(err, "GetTenantConfigsWithResponse")
+	s.Equal(http.StatusBadRequest, unknownResp.StatusCode(), "unknown tenant")
+
+	pushResp, err := mimirClient.PostTenantConfigsWithResponse(clientCtx, srv_api.PostTenantConfigsJSONRequestBody{
+		Tenants:            []string{"devops"},
+		AlertmanagerConfig: "route:\n  receiver: default\nreceivers:\n- name: default\n",
+	})
+	s.NoError(err, "PostTenantConfigsWithResponse")
+	s.Equal(http.StatusForbidden, pushResp.StatusCode(), "push disabled")
+}
+
+func (s *AlertmanagerSuite) TestTenantConfigsPush() {
+	log := logger.GetLogger(buildinfo.BuildInfo.AppName(), slog.LevelDebug).With(logger.KeyTestCase, s.T().Name())
+	serverConfig := &configs.ServerConfig{
+		Alerts: &configs.AlertsConfig{
+			AlertmanagerUrl: "http://localhost:8085/alertmanager/api/v2",
+			ConfigPush:      true,
+			Tenants:         []string{"devops", "app-development"},
+			TenantLabel:     "tenant",
+		},
+	}
+	testConfig := &configs.TestConfig{
+		CaptureTransportMode: mw_client_model.CaptureTransportModeFake,
+		CaptureDir:           "../testdata/capture",
+		CaptureMatchers: []mw_client_model.CaptureMatcher{
+			mw_client.CaptureEqualRequestURLAndHeader(configs.HttpHeaderXscopeorgid),
+		},
+	}
+	amConfig, err := os.ReadFile("../testdata/mimir_config/baseline.yaml")
+	s.NoError(err, "baseline.yaml")
+	devopsTmpl, err := os.ReadFile("../testdata/mimir_config/devops.tmpl")
+	s.NoError(err, "devops.tmpl")
+
+	server := runTestServer(s.T(), serverConfig, testConfig, []string{"multitenant-alertmanager"}, log)
+	defer server.Cancel()
+
+	testRootUrl, err := url.JoinPath(server.TestServer.URL, "/multitenant-alertmanager/api/v2")
+	s.NoError(err, "testRootUrl")
+	mimirClient, err := srv_api.NewClientWithResponses(
+		testRootUrl,
+		srv_api.WithHTTPClient(srv_utils.NewHttpClient()),
+	)
+	s.NoError(err, "srv_api.NewClientWithResponses")
+
+	clientCtx := logger.NewContext(context.Background(), log)
+	invalidResp, err := mimirClient.PostTenantConfigsWithResponse(clientCtx, srv_api.PostTenantConfigsJSONRequestBody{
+		Tenants:            []string{"devops"},
+		AlertmanagerConfig: string(amConfig),
+	})
+	s.NoError(err, "PostTenantConfigsWithResponse")
+	s.Equal(http.StatusBadRequest, invalidResp.StatusCode(), "missing template")
+	s.NotNil(invalidResp.JSON400, "invalidResp.JSON400")
+	s.Contains(*invalidResp.JSON400, srv_api.ConfigFinding{
+		Severity: srv_api.ConfigFindingError,
+		Message:  `template pattern "devops.tmpl" matches no template file`,
+	}, "findings")
+
+	templateFiles := srv_api.LabelSet{"devops.tmpl": string(devopsTmpl)}
+	pushResp, err := mimirClient.PostTenantConfigsWithResponse(clientCtx, srv_api.PostTenantConfigsJSONRequestBody{
+		Tenants:            []string{"devops"},
+		AlertmanagerConfig: string(amConfig),
+		TemplateFiles:      &templateFiles,
+	})
+	s.NoError(err, "PostTenantConfigsWithResponse")
+	s.NotNil(pushResp.JSON200, "pushResp.JSON200")
+	s.Equal(srv_api.TenantConfigPushResults{{Tenant: "devops", Pushed: true}}, *pushResp.JSON200, "results")
+
+	disabledConfig := *serverConfig.Alerts
+	disabledConfig.ConfigPush = false
+	tenantConfigs, err := alertmanager.NewTenantConfigs(clientCtx, &disabledConfig, testConfig, alertmanager.TargetServiceNameConfig)
+	s.NoError(err, "NewTenantConfigs")
+	_, _, err = tenantConfigs.Push(clientCtx, []string{"devops"},
+		&config_api.UserConfig{AlertmanagerConfig: string(amConfig)})
+	s.ErrorIs(err, alertmanager.ErrConfigPushDisabled, "push disabled")
+}
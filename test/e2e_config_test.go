@@ -0,0 +1,56 @@
+package test
+
+import (
+	"errors"
+
+	am_config "github.com/prometheus/alertmanager/config"
+
+	"github.com/pgillich/mimir-multitenant_alertmanager/configs"
+	notifyer "github.com/pgillich/mimir-multitenant_alertmanager/internal/notifyer"
+)
+
+func configErrorPaths(configErrors configs.ConfigErrors) []string {
+	paths := make([]string, 0, len(configErrors))
+	for _, configError := range configErrors {
+		paths = append(paths, configError.Path)
+	}
+
+	return paths
+}
+
+func (s *NotifyerSuite) TestValidateConfig() {
+	serverConfig := s.newNotifyerServerConfig("2525")
+	s.Empty(serverConfig.Validate(), "ServerConfig")
+	s.Empty(serverConfig.Alerts.Validate(), "Alerts")
+	s.Empty(notifyer.ValidateConfig(serverConfig.Alerts, serverConfig.Notifyer), "Notifyer")
+
+	serverConfig.ListenAddr = "localhost"
+	serverConfig.Alerts.AlertmanagerUrl = "localhost:8085/alertmanager/api/v2"
+	serverConfig.Alerts.Tenants = []string{"devops", "", "devops"}
+	serverConfig.Notifyer.PollPeriodSec = 0
+	serverConfig.Notifyer.Templates = append(serverConfig.Notifyer.Templates, `{{ define "broken" }}{{ .Foo | nofunc }}{{ end }}`)
+	serverConfig.Notifyer.Receivers[0].EmailConfigs[0].From = ""
+	serverConfig.Notifyer.Receivers[0].EmailConfigs[0].Text = "{{ .Foo "
+	serverConfig.Notifyer.Route.Routes = []*am_config.Route{{Receiver: "missing"}}
+
+	s.Equal([]string{"listenAddr"}, configErrorPaths(serverConfig.Validate()), "ServerConfig")
+	s.Equal([]string{
+		"alerts.alertmanagerUrl",
+		"alerts.tenants[1]",
+		"alerts.tenants[2]",
+	}, configErrorPaths(serverConfig.Alerts.Validate()), "Alerts")
+	configErrors := notifyer.ValidateConfig(serverConfig.Alerts, serverConfig.Notifyer)
+	s.Equal([]string{
+		"notifyer.pollPeriodSec",
+		"notifyer.receivers[0].emailConfigs[0].from",
+		"notifyer.route.routes[0].receiver",
+		"notifyer.templates[3]",
+		"notifyer.receivers[0].emailConfigs[0].text",
+	}, configErrorPaths(configErrors), "Notifyer")
+	s.True(errors.Is(configErrors.Err(), configs.ErrInvalidServerConfig), "ErrInvalidServerConfig")
+
+	serverConfig.Alerts = nil
+	serverConfig.Notifyer = nil
+	s.Equal([]string{"alerts"}, configErrorPaths(serverConfig.Alerts.Validate()), "Alerts")
+	s.Equal([]string{"notifyer"}, configErrorPaths(notifyer.ValidateConfig(serverConfig.Alerts, serverConfig.Notifyer)), "Notifyer")
+}
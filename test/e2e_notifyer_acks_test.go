@@ -0,0 +1,69 @@
+package test
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	am_config "github.com/prometheus/alertmanager/config"
+
+	"github.com/pgillich/micro-server/pkg/logger"
+
+	"github.com/pgillich/mimir-multitenant_alertmanager/configs"
+	"github.com/pgillich/mimir-multitenant_alertmanager/internal/buildinfo"
+	notifyer "github.com/pgillich/mimir-multitenant_alertmanager/internal/notifyer"
+	notifyer_api "github.com/pgillich/mimir-multitenant_alertmanager/pkg/api/notifyer"
+)
+
+func (s *NotifyerSuite) TestAckedNotifications() {
+	log := logger.GetLogger(buildinfo.BuildInfo.AppName(), slog.LevelDebug).With(logger.KeyTestCase, s.T().Name())
+	ctx := logger.NewContext(context.Background(), log)
+	serverConfig := s.newNotifyerServerConfig("2525")
+	serverConfig.Notifyer.Receivers = append(serverConfig.Notifyer.Receivers[:1], am_config.Receiver{
+		Name: "devops", EmailConfigs: serverConfig.Notifyer.Receivers[0].EmailConfigs,
+	})
+	serverConfig.Notifyer.Route.Routes = []*am_config.Route{{Receiver: "email", Match: map[string]string{"tenant": "devops"}}}
+	serverConfig.Notifyer.Escalations = []configs.EscalationConfig{{
+		RouteID: `{}/{tenant="devops"}/0`,
+		Steps:   []configs.EscalationStepConfig{{Receiver: "devops", DelaySec: 900}},
+	}}
+
+	start := time.Date(2024, 12, 13, 19, 30, 0, 0, time.UTC)
+	alertAt := func(state notifyer_api.AlertStatusState, acked bool) notifyer_api.GettableAlert {
+		alert := notifyer_api.GettableAlert{
+			Labels:      notifyer_api.LabelSet{"alertname": "Acked", "tenant": "devops"},
+			Annotations: notifyer_api.LabelSet{},
+			StartsAt:    start,
+			EndsAt:      start.Add(30 * time.Minute),
+			Status:      notifyer_api.AlertStatus{State: state},
+		}
+		if acked {
+			alert.Annotations[configs.AckedByAnnotation] = "jdoe"
+			alert.Annotations[configs.AckedAtAnnotation] = start.Add(5 * time.Minute).Format(time.RFC3339)
+		}
+
+		return alert
+	}
+	steps := []notifyer.SimulationStep{
+		{Time: start, Alerts: notifyer_api.GettableAlerts{alertAt(notifyer_api.Active, false)}},
+		{Time: start.Add(5 * time.Minute), Alerts: notifyer_api.GettableAlerts{alertAt(notifyer_api.Active, true)}},
+		{Time: start.Add(10 * time.Minute), Alerts: notifyer_api.GettableAlerts{alertAt(notifyer_api.Unprocessed, true)}},
+		{Time: start.Add(15 * time.Minute), Alerts: notifyer_api.GettableAlerts{alertAt(notifyer_api.Active, true)}},
+		{Time: start.Add(40 * time.Minute), Alerts: notifyer_api.GettableAlerts{}},
+	}
+
+	simulator, err := notifyer.NewSimulator(ctx, serverConfig.Alerts, serverConfig.Notifyer)
+	s.NoError(err, "NewSimulator")
+	timeline, err := simulator.Run(ctx, steps)
+	s.NoError(err, "Run")
+
+	notifications := []string{}
+	for _, entry := range timeline {
+		for _, notification := range entry.Notifications {
+			notifications = append(notifications, entry.Time.Sub(start).String()+" "+notification.Receiver+" "+notification.Status)
+		}
+	}
+	s.Equal([]string{"0s email firing", "40m0s email resolved"}, notifications, "notifications")
+	s.Len(timeline[0].Escalations, 1, "Escalations started")
+	s.Empty(timeline[1].Escalations, "Escalations after ack")
+}
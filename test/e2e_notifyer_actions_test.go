@@ -0,0 +1,58 @@
+package test
+
+import (
+	"context"
+	"log/slog"
+	"net/url"
+	"strconv"
+	"time"
+
+	"github.com/pgillich/micro-server/pkg/logger"
+
+	"github.com/pgillich/mimir-multitenant_alertmanager/internal/actionlink"
+	"github.com/pgillich/mimir-multitenant_alertmanager/internal/buildinfo"
+	notifyer "github.com/pgillich/mimir-multitenant_alertmanager/internal/notifyer"
+	notifyer_api "github.com/pgillich/mimir-multitenant_alertmanager/pkg/api/notifyer"
+)
+
+func (s *NotifyerSuite) TestActionLinkTemplates() {
+	log := logger.GetLogger(buildinfo.BuildInfo.AppName(), slog.LevelDebug).With(logger.KeyTestCase, s.T().Name())
+	ctx := logger.NewContext(context.Background(), log)
+	serverConfig := s.newNotifyerServerConfig("2525")
+	serverConfig.Alerts.ActionLinkSecret = "action-link-secret"
+	serverConfig.Notifyer.Receivers[0].EmailConfigs[0].Headers = map[string]string{
+		"Subject": `{{ range .Alerts }}{{ actionSilenceURL . "2h" }}{{ end }}`,
+	}
+
+	start := time.Now()
+	simulator, err := notifyer.NewSimulator(ctx, serverConfig.Alerts, serverConfig.Notifyer)
+	s.NoError(err, "NewSimulator")
+	entry, err := simulator.Step(ctx, notifyer.SimulationStep{Time: start, Alerts: notifyer_api.GettableAlerts{{
+		Labels: notifyer_api.LabelSet{"alertname": "Action", "tenant": "devops"},
+		EndsAt: start.Add(time.Hour),
+	}}})
+	s.NoError(err, "Step")
+	if !s.Len(entry.Notifications, 1, "Notifications") {
+		return
+	}
+
+	actionURL, err := url.Parse(entry.Notifications[0].Subject)
+	s.NoError(err, "actionURL")
+	s.Equal("http://ExternalURL/multitenant-alertmanager/api/v2/actions/silence", actionURL.Scheme+"://"+actionURL.Host+actionURL.Path, "actionURL")
+	query := actionURL.Query()
+	expires, err := strconv.ParseInt(query.Get("expires"), 10, 64)
+	s.NoError(err, "expires")
+	link := actionlink.Link{
+		Action:      actionlink.ActionSilence,
+		Tenant:      query.Get("tenant"),
+		Fingerprint: query.Get("fingerprint"),
+		Duration:    query.Get("duration"),
+		Expires:     time.Unix(expires, 0),
+	}
+	s.Equal("devops", link.Tenant, "tenant")
+	s.Equal("2h", link.Duration, "duration")
+	s.NoError(actionlink.Verify("action-link-secret", link, query.Get("signature"), start), "Verify")
+	s.ErrorIs(actionlink.Verify("other-secret", link, query.Get("signature"), start), actionlink.ErrInvalidSignature, "Verify")
+	s.ErrorIs(actionlink.Verify("action-link-secret", link, query.Get("signature"), start.Add(actionlink.DefaultTTL+time.Minute)),
+		actionlink.ErrExpired, "Verify")
+}
@@ -0,0 +1,72 @@
+package test
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	"github.com/pgillich/micro-server/pkg/logger"
+
+	"github.com/pgillich/mimir-multitenant_alertmanager/configs"
+	"github.com/pgillich/mimir-multitenant_alertmanager/internal/buildinfo"
+	notifyer "github.com/pgillich/mimir-multitenant_alertmanager/internal/notifyer"
+	notifyer_api "github.com/pgillich/mimir-multitenant_alertmanager/pkg/api/notifyer"
+)
+
+func (s *NotifyerSuite) TestChangeDetection() {
+	log := logger.GetLogger(buildinfo.BuildInfo.AppName(), slog.LevelDebug).With(logger.KeyTestCase, s.T().Name())
+	ctx := logger.NewContext(context.Background(), log)
+	serverConfig := s.newNotifyerServerConfig("2525")
+	serverConfig.Notifyer.ChangeDetection = configs.ChangeDetectionConfig{
+		Labels: []string{"severity"}, Annotations: []string{"summary"}, MinIntervalSec: 600,
+	}
+	serverConfig.Notifyer.Receivers[0].EmailConfigs[0].Headers["Subject"] = `{{ .Status }}{{ range .Alerts }} {{ .Labels.alertname }}` +
+		`{{ range alertChanges . }}, {{ .Key }} changed from {{ .Old }} to {{ .New }}{{ end }}{{ end }}`
+	s.Empty(notifyer.ValidateConfig(serverConfig.Alerts, serverConfig.Notifyer), "ValidateConfig")
+
+	start := time.Date(2024, 12, 13, 19, 30, 0, 0, time.UTC)
+	highCPU := func(severity string, summary string) notifyer_api.GettableAlert {
+		return notifyer_api.GettableAlert{
+			Labels:      notifyer_api.LabelSet{"alertname": "HighCPU", "tenant": "devops", "severity": severity},
+			Annotations: notifyer_api.LabelSet{"summary": summary},
+			StartsAt:    start,
+			EndsAt:      start.Add(time.Hour),
+		}
+	}
+	suppressedBefore := s.suppressedNotifications()
+
+	simulator, err := notifyer.NewSimulator(ctx, serverConfig.Alerts, serverConfig.Notifyer)
+	s.NoError(err, "NewSimulator")
+	timeline, err := simulator.Run(ctx, []notifyer.SimulationStep{
+		{Time: start, Alerts: notifyer_api.GettableAlerts{highCPU("warning", "CPU 80%")}},
+		{Time: start.Add(time.Minute), Alerts: notifyer_api.GettableAlerts{highCPU("warning", "CPU 90%")}},
+		{Time: start.Add(2 * time.Minute), Alerts: notifyer_api.GettableAlerts{highCPU("critical", "CPU 90%")}},
+		{Time: start.Add(15 * time.Minute), Alerts: notifyer_api.GettableAlerts{highCPU("critical", "CPU 95%")}},
+		{Time: start.Add(30 * time.Minute), Alerts: notifyer_api.GettableAlerts{highCPU("warning", "CPU 95%")}},
+		{Time: start.Add(31 * time.Minute), Alerts: notifyer_api.GettableAlerts{highCPU("warning", "CPU 95%")}},
+	})
+	s.NoError(err, "Run")
+
+	notifications := []string{}
+	for _, entry := range timeline {
+		for _, notification := range entry.Notifications {
+			notifications = append(notifications, entry.Time.Sub(start).String()+" "+notification.Subject)
+		}
+	}
+	s.Equal([]string{
+		"0s firing HighCPU",
+		"1m0s firing HighCPU, summary changed from CPU 80% to CPU 90%",
+		"15m0s firing HighCPU, summary changed from CPU 90% to CPU 95%",
+		"30m0s firing HighCPU, severity changed from critical to warning",
+	}, notifications, "notifications")
+	s.Equal(float64(1), s.suppressedNotifications()-suppressedBefore, "suppressed notifications")
+
+	serverConfig.Notifyer.ChangeDetection = configs.ChangeDetectionConfig{
+		Labels: []string{"bad-label"}, Annotations: []string{""}, MinIntervalSec: -1,
+	}
+	s.Equal([]string{
+		"notifyer.changeDetection.labels[0]",
+		"notifyer.changeDetection.annotations[0]",
+		"notifyer.changeDetection.minIntervalSec",
+	}, configErrorPaths(notifyer.ValidateConfig(serverConfig.Alerts, serverConfig.Notifyer)), "ValidateConfig")
+}
@@ -0,0 +1,108 @@
+package test
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	am_config "github.com/prometheus/alertmanager/config"
+
+	"github.com/pgillich/micro-server/pkg/logger"
+
+	"github.com/pgillich/mimir-multitenant_alertmanager/configs"
+	"github.com/pgillich/mimir-multitenant_alertmanager/internal/buildinfo"
+	notifyer "github.com/pgillich/mimir-multitenant_alertmanager/internal/notifyer"
+	notifyer_api "github.com/pgillich/mimir-multitenant_alertmanager/pkg/api/notifyer"
+)
+
+func (s *NotifyerSuite) TestDigest() {
+	log := logger.GetLogger(buildinfo.BuildInfo.AppName(), slog.LevelDebug).With(logger.KeyTestCase, s.T().Name())
+	ctx := logger.NewContext(context.Background(), log)
+	serverConfig := s.newNotifyerServerConfig("2525")
+	emailConfig := *serverConfig.Notifyer.Receivers[0].EmailConfigs[0]
+	emailConfig.To = "devops@localhost"
+	serverConfig.Notifyer.Receivers = append(serverConfig.Notifyer.Receivers[:1], am_config.Receiver{
+		Name: "devops", EmailConfigs: []*am_config.EmailConfig{&emailConfig},
+	})
+	serverConfig.Notifyer.Route.Routes = []*am_config.Route{{Receiver: "devops", Match: map[string]string{"tenant": "devops"}}}
+	serverConfig.Notifyer.Digests = []configs.DigestConfig{{
+		Receiver: "devops", Schedule: "0 8,16 * * 1-5", Location: "Europe/Budapest",
+	}}
+
+	start := time.Date(2024, 12, 13, 6, 0, 0, 0, time.UTC) // Friday, 07:00 in Budapest
+	alertAt := func(tenant string, severity string, endsAt time.Duration) notifyer_api.GettableAlert {
+		return notifyer_api.GettableAlert{
+			Labels:   notifyer_api.LabelSet{"alertname": "Digested", "tenant": tenant, "severity": severity},
+			StartsAt: start,
+			EndsAt:   start.Add(endsAt),
+		}
+	}
+	steps := []notifyer.SimulationStep{
+		{Time: start, Alerts: notifyer_api.GettableAlerts{
+			alertAt("devops", "critical", 4*time.Hour), alertAt("devops", "warning", 30*time.Minute),
+			alertAt("app-development", "critical", 4*time.Hour),
+		}},
+		{Time: start.Add(40 * time.Minute), Alerts: notifyer_api.GettableAlerts{
+			alertAt("devops", "critical", 4*time.Hour), alertAt("app-development", "critical", 4*time.Hour),
+		}},
+		{Time: start.Add(time.Hour), Alerts: notifyer_api.GettableAlerts{alertAt("devops", "critical", 4*time.Hour)}},
+		{Time: start.Add(90 * time.Minute), Alerts: notifyer_api.GettableAlerts{alertAt("devops", "critical", 4*time.Hour)}},
+		{Time: start.Add(9 * time.Hour), Alerts: notifyer_api.GettableAlerts{}},
+		{Time: start.Add(25 * time.Hour), Alerts: notifyer_api.GettableAlerts{}},
+	}
+
+	simulator, err := notifyer.NewSimulator(ctx, serverConfig.Alerts, serverConfig.Notifyer)
+	s.NoError(err, "NewSimulator")
+	timeline, err := simulator.Run(ctx, steps)
+	s.NoError(err, "Run")
+
+	notifications := []string{}
+	for _, entry := range timeline {
+		for _, notification := range entry.Notifications {
+			notifications = append(notifications, entry.Time.Sub(start).String()+" "+notification.Receiver+" "+notification.Subject)
+		}
+	}
+	s.Equal([]string{
+		"0s email [FIRING:1]  (Digested critical app-development)",
+		"1h0m0s email [RESOLVED:1]  (Digested critical app-development)",
+		"1h0m0s devops [DIGEST] devops: 1 firing, 1 resolved",
+		"9h0m0s devops [DIGEST] devops: 0 firing, 1 resolved",
+	}, notifications, "notifications")
+	if s.Len(timeline[2].Notifications, 2, "disappeared and digest") {
+		s.Contains(timeline[2].Notifications[0].Text, "disappeared = true", "disappeared")
+		s.Contains(timeline[2].Notifications[1].Html, "<h3>devops / critical</h3>", "Html")
+		s.Contains(timeline[2].Notifications[1].Html, "<h3>devops / warning</h3>", "Html")
+	}
+
+	serverConfig.Notifyer.Digests[0].Subject = `{{ if .CommonAnnotations.fail }}{{ template "missing" . }}{{ end }}` +
+		`{{ template "digest.default.subject" . }}`
+	failing := []notifyer_api.GettableAlert{alertAt("devops", "critical", 4*time.Hour), alertAt("devops", "warning", 30*time.Minute)}
+	for a := range failing {
+		failing[a].Annotations = notifyer_api.LabelSet{"fail": "true"}
+	}
+	simulator, err = notifyer.NewSimulator(ctx, serverConfig.Alerts, serverConfig.Notifyer)
+	s.NoError(err, "NewSimulator failing digest")
+	_, err = simulator.Step(ctx, notifyer.SimulationStep{Time: start, Alerts: failing})
+	s.NoError(err, "Step before digest")
+	_, err = simulator.Step(ctx, notifyer.SimulationStep{Time: start.Add(time.Hour), Alerts: failing[:1]})
+	s.Error(err, "Step failing digest")
+	entry, err := simulator.Step(ctx, notifyer.SimulationStep{
+		Time: start.Add(90 * time.Minute), Alerts: notifyer_api.GettableAlerts{alertAt("devops", "critical", 4*time.Hour)},
+	})
+	s.NoError(err, "Step digest sent again")
+	if s.Len(entry.Notifications, 1, "digest sent again") {
+		s.Equal("[DIGEST] devops: 1 firing, 1 resolved", entry.Notifications[0].Subject, "Subject")
+	}
+
+	serverConfig.Notifyer.Digests = []configs.DigestConfig{
+		{Receiver: "devops", Schedule: "61 * * * *"},
+		{Receiver: "unknown", Schedule: "@daily", Template: "undefined"},
+	}
+	s.Equal([]string{
+		"notifyer.digests[1].receiver",
+		"notifyer.digests[0].schedule",
+		"notifyer.digests[1].template",
+	}, configErrorPaths(notifyer.ValidateConfig(serverConfig.Alerts, serverConfig.Notifyer)), "ValidateConfig")
+	_, err = notifyer.NewSimulator(ctx, serverConfig.Alerts, serverConfig.Notifyer)
+	s.ErrorIs(err, notifyer.ErrInvalidSchedule, "invalid schedule")
+}
@@ -0,0 +1,64 @@
+package test
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	"github.com/pgillich/micro-server/pkg/logger"
+
+	"github.com/pgillich/mimir-multitenant_alertmanager/internal/buildinfo"
+	notifyer "github.com/pgillich/mimir-multitenant_alertmanager/internal/notifyer"
+	notifyer_api "github.com/pgillich/mimir-multitenant_alertmanager/pkg/api/notifyer"
+)
+
+func (s *NotifyerSuite) TestDisappearedAlerts() {
+	log := logger.GetLogger(buildinfo.BuildInfo.AppName(), slog.LevelDebug).With(logger.KeyTestCase, s.T().Name())
+	ctx := logger.NewContext(context.Background(), log)
+	serverConfig := s.newNotifyerServerConfig("2525")
+	serverConfig.Notifyer.DisappearedGracePolls = 1
+	serverConfig.Notifyer.Receivers[0].EmailConfigs[0].Headers["Subject"] =
+		`{{ .Status }}{{ range .Alerts }} {{ .Labels.alertname }}{{ with .Annotations.disappeared }} disappeared{{ end }}{{ end }}`
+
+	start := time.Date(2024, 12, 13, 19, 30, 0, 0, time.UTC)
+	newAlert := func(alertname string, tenant string) notifyer_api.GettableAlert {
+		return notifyer_api.GettableAlert{
+			Labels: notifyer_api.LabelSet{"alertname": alertname, "tenant": tenant},
+			EndsAt: start.Add(time.Hour),
+		}
+	}
+	diskFull := newAlert("DiskFull", "devops")
+	flaky := newAlert("Flaky", "devops")
+	highCPU := newAlert("HighCPU", "app-development")
+
+	simulator, err := notifyer.NewSimulator(ctx, serverConfig.Alerts, serverConfig.Notifyer)
+	s.NoError(err, "NewSimulator")
+	timeline, err := simulator.Run(ctx, []notifyer.SimulationStep{
+		{Time: start, Alerts: notifyer_api.GettableAlerts{diskFull, flaky, highCPU}},
+		{Time: start.Add(time.Minute), Alerts: notifyer_api.GettableAlerts{}, FailedTenants: []string{"app-development"}},
+		{Time: start.Add(2 * time.Minute), Alerts: notifyer_api.GettableAlerts{flaky}, FailedTenants: []string{"app-development"}},
+		{Time: start.Add(3 * time.Minute), Alerts: notifyer_api.GettableAlerts{flaky}},
+		{Time: start.Add(4 * time.Minute), Alerts: notifyer_api.GettableAlerts{}},
+		{Time: start.Add(5 * time.Minute), Alerts: notifyer_api.GettableAlerts{}},
+	})
+	s.NoError(err, "Run")
+	if !s.Len(timeline, 6, "timeline") {
+		return
+	}
+	subjects := func(entry notifyer.TimelineEntry) []string {
+		subjects := []string{}
+		for _, notification := range entry.Notifications {
+			subjects = append(subjects, notification.Subject)
+		}
+
+		return subjects
+	}
+
+	s.Equal([]string{"firing DiskFull Flaky HighCPU"}, subjects(timeline[0]), "new alerts")
+	s.Empty(timeline[1].Notifications, "grace period and failed tenant")
+	s.Equal([]string{"resolved DiskFull disappeared"}, subjects(timeline[2]), "DiskFull disappeared")
+	s.Equal(1, timeline[2].Resolved, "Resolved")
+	s.Empty(timeline[3].Notifications, "HighCPU in grace period")
+	s.Equal([]string{"resolved HighCPU disappeared"}, subjects(timeline[4]), "HighCPU disappeared")
+	s.Equal([]string{"resolved Flaky disappeared"}, subjects(timeline[5]), "Flaky disappeared")
+}
@@ -0,0 +1,98 @@
+package test
+
+import (
+	"context"
+	"log/slog"
+	"net/http"
+	"net/url"
+	"time"
+
+	am_config "github.com/prometheus/alertmanager/config"
+
+	"github.com/pgillich/micro-server/pkg/logger"
+	srv_utils "github.com/pgillich/micro-server/pkg/utils"
+
+	"github.com/pgillich/mimir-multitenant_alertmanager/internal/buildinfo"
+	notifyer_api "github.com/pgillich/mimir-multitenant_alertmanager/pkg/api/notifyer"
+)
+
+// waitDryRunNotifications waits for the dry-run notifications of the first evaluation
+func (s *NotifyerSuite) waitDryRunNotifications(ctx context.Context, client *notifyer_api.ClientWithResponses,
+	params *notifyer_api.GetDryRunNotificationsParams,
+) []notifyer_api.DryRunNotification {
+	for range 50 {
+		resp, err := client.GetDryRunNotificationsWithResponse(ctx, params)
+		s.NoError(err, "GetDryRunNotificationsWithResponse")
+		s.Equal(http.StatusOK, resp.StatusCode(), "StatusCode")
+		if resp.JSON200 != nil && len(*resp.JSON200) > 0 {
+			return *resp.JSON200
+		}
+		time.Sleep(100 * time.Millisecond)
+	}
+	s.Fail("dry-run notifications not found")
+
+	return []notifyer_api.DryRunNotification{}
+}
+
+func (s *NotifyerSuite) TestDryRun() {
+	log := logger.GetLogger(buildinfo.BuildInfo.AppName(), slog.LevelDebug).With(logger.KeyTestCase, s.T().Name())
+	smtpServer := StartSmtpServer(log, "localhost:2529")
+	defer smtpServer.Close()
+
+	serverConfig := s.newNotifyerServerConfig("2529")
+	dryRunReceiver := *serverConfig.Notifyer.Receivers[0].EmailConfigs[0]
+	dryRunReceiver.To = "devops@localhost"
+	dryRunReceiver.Headers = map[string]string{"Subject": `{{ .Status }} {{ .GroupLabels.tenant }}`}
+	serverConfig.Notifyer.Receivers = append(serverConfig.Notifyer.Receivers[:1], am_config.Receiver{
+		Name: "devops", EmailConfigs: []*am_config.EmailConfig{&dryRunReceiver},
+	})
+	serverConfig.Notifyer.DryRunReceivers = []string{"devops"}
+	serverConfig.Notifyer.DryRunLogSize = 10
+	serverConfig.Notifyer.Route.Routes = []*am_config.Route{{
+		Receiver:   "devops",
+		Match:      map[string]string{"tenant": "devops"},
+		GroupByStr: []string{"tenant"},
+	}}
+
+	server := runTestServer(s.T(), serverConfig, newNotifyerTestConfig(),
+		[]string{"multitenant-alertmanager", "notifyer"}, log)
+	defer server.Cancel()
+
+	testRootUrl, err := url.JoinPath(server.TestServer.URL, "/notifyer/api/v2")
+	s.NoError(err, "testRootUrl")
+	clientCtx := logger.NewContext(context.Background(), log)
+	alerts := s.waitNotifyerAlerts(clientCtx, testRootUrl)
+	client, err := notifyer_api.NewClientWithResponses(testRootUrl, notifyer_api.WithHTTPClient(srv_utils.NewHttpClient()))
+	s.NoError(err, "notifyer_api.NewClientWithResponses")
+
+	notifications := s.waitDryRunNotifications(clientCtx, client, &notifyer_api.GetDryRunNotificationsParams{})
+	devopsAlerts := 0
+	for _, alert := range alerts {
+		if alert.Labels["tenant"] == "devops" {
+			devopsAlerts++
+		}
+	}
+	notifiedAlerts := 0
+	for n, notification := range notifications {
+		s.Equal(uint64(n+1), notification.Id, "Id")
+		s.Equal("devops", notification.Receiver, "Receiver")
+		s.Equal("email[0]", notification.Integration, "Integration")
+		s.Equal(`{}/{tenant="devops"}:{tenant="devops"}`, notification.GroupKey, "GroupKey")
+		s.Equal(notifyer_api.LabelSet{"tenant": "devops"}, notification.GroupLabels, "GroupLabels")
+		s.Equal("devops@localhost", notification.To, "To")
+		s.Equal(notification.Status+" devops", notification.Subject, "Subject")
+		s.Contains(notification.Text, "devops", "Text")
+		for _, alertLabels := range notification.Alerts {
+			s.Equal("devops", alertLabels["tenant"], "tenant")
+		}
+		notifiedAlerts += len(notification.Alerts)
+	}
+	s.Equal(devopsAlerts, notifiedAlerts, "all devops alerts are recorded")
+
+	emailReceiver := "email"
+	resp, err := client.GetDryRunNotificationsWithResponse(clientCtx, &notifyer_api.GetDryRunNotificationsParams{Receiver: &emailReceiver})
+	s.NoError(err, "GetDryRunNotificationsWithResponse")
+	if s.NotNil(resp.JSON200, "JSON200") {
+		s.Empty(*resp.JSON200, "email receiver is not in dry-run mode")
+	}
+}
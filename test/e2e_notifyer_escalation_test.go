@@ -0,0 +1,121 @@
+package test
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	am_config "github.com/prometheus/alertmanager/config"
+
+	"github.com/pgillich/micro-server/pkg/logger"
+
+	"github.com/pgillich/mimir-multitenant_alertmanager/configs"
+	"github.com/pgillich/mimir-multitenant_alertmanager/internal/buildinfo"
+	notifyer "github.com/pgillich/mimir-multitenant_alertmanager/internal/notifyer"
+	notifyer_api "github.com/pgillich/mimir-multitenant_alertmanager/pkg/api/notifyer"
+)
+
+func (s *NotifyerSuite) TestEscalation() {
+	log := logger.GetLogger(buildinfo.BuildInfo.AppName(), slog.LevelDebug).With(logger.KeyTestCase, s.T().Name())
+	ctx := logger.NewContext(context.Background(), log)
+	serverConfig := s.newNotifyerServerConfig("2525")
+	serverConfig.Notifyer.Receivers = append(serverConfig.Notifyer.Receivers[:1],
+		am_config.Receiver{Name: "devops"}, am_config.Receiver{Name: "critical"})
+	for r := range serverConfig.Notifyer.Receivers[1:] {
+		emailConfig := *serverConfig.Notifyer.Receivers[0].EmailConfigs[0]
+		emailConfig.To = serverConfig.Notifyer.Receivers[r+1].Name + "@localhost"
+		serverConfig.Notifyer.Receivers[r+1].EmailConfigs = []*am_config.EmailConfig{&emailConfig}
+	}
+	serverConfig.Notifyer.Route.Routes = []*am_config.Route{
+		{Receiver: "email", Match: map[string]string{"tenant": "devops"}},
+		{Receiver: "email", Match: map[string]string{"tenant": "app-development"}},
+	}
+	chain := []configs.EscalationStepConfig{{Receiver: "devops", DelaySec: 900}, {Receiver: "critical", DelaySec: 1800}}
+	serverConfig.Notifyer.Escalations = []configs.EscalationConfig{
+		{RouteID: `{}/{tenant="devops"}/0`, Steps: chain},
+		{RouteID: `{}/{tenant="app-development"}/1`, Steps: chain},
+	}
+
+	start := time.Date(2024, 12, 13, 19, 30, 0, 0, time.UTC)
+	alertAt := func(tenant string, endsAt time.Duration) notifyer_api.GettableAlert {
+		return notifyer_api.GettableAlert{
+			Labels:   notifyer_api.LabelSet{"alertname": "Escalated", "tenant": tenant},
+			StartsAt: start,
+			EndsAt:   start.Add(endsAt),
+		}
+	}
+	silenced := alertAt("devops", 2*time.Hour)
+	silenced.Status = notifyer_api.AlertStatus{State: notifyer_api.Suppressed, SilencedBy: []string{"silence"}}
+	steps := []notifyer.SimulationStep{
+		{Time: start, Alerts: notifyer_api.GettableAlerts{alertAt("devops", 2*time.Hour), alertAt("app-development", 5*time.Minute)}},
+		{Time: start.Add(10 * time.Minute), Alerts: notifyer_api.GettableAlerts{alertAt("devops", 2*time.Hour)}},
+		{Time: start.Add(15 * time.Minute), Alerts: notifyer_api.GettableAlerts{alertAt("devops", 2*time.Hour)}},
+		{Time: start.Add(20 * time.Minute), Alerts: notifyer_api.GettableAlerts{alertAt("devops", 2*time.Hour)}},
+		{Time: start.Add(30 * time.Minute), Alerts: notifyer_api.GettableAlerts{alertAt("devops", 2*time.Hour)}},
+		{Time: start.Add(40 * time.Minute), Alerts: notifyer_api.GettableAlerts{silenced}},
+	}
+
+	simulator, err := notifyer.NewSimulator(ctx, serverConfig.Alerts, serverConfig.Notifyer)
+	s.NoError(err, "NewSimulator")
+	timeline, err := simulator.Run(ctx, steps)
+	s.NoError(err, "Run")
+
+	notifications := []string{}
+	for _, entry := range timeline {
+		for _, notification := range entry.Notifications {
+			notifications = append(notifications, entry.Time.Sub(start).String()+" "+notification.Receiver+" "+
+				notification.Alerts[0]["tenant"]+" "+notification.Status)
+		}
+	}
+	s.Equal([]string{
+		"0s email app-development firing",
+		"0s email devops firing",
+		"10m0s email app-development resolved",
+		"15m0s devops devops firing",
+		"30m0s critical devops firing",
+		"40m0s email devops firing",
+	}, notifications, "notifications")
+
+	if s.Len(timeline[0].Escalations, 2, "Escalations started") {
+		s.Equal("email", timeline[0].Escalations[1].Receiver, "Receiver")
+		s.Equal(start, timeline[0].Escalations[1].StartedAt, "StartedAt")
+		s.Equal("devops", *timeline[0].Escalations[1].NextReceiver, "NextReceiver")
+		s.Equal(start.Add(15*time.Minute), *timeline[0].Escalations[1].NextAt, "NextAt")
+	}
+	if s.Len(timeline[3].Escalations, 1, "Escalations after resolve") {
+		s.Equal(`{}/{tenant="devops"}/0`, timeline[3].Escalations[0].RouteId, "RouteId")
+		s.Equal([]string{"devops"}, timeline[3].Escalations[0].EscalatedTo, "EscalatedTo")
+		s.Equal(start.Add(30*time.Minute), *timeline[3].Escalations[0].NextAt, "NextAt")
+	}
+	if s.Len(timeline[4].Escalations, 1, "Escalations finished") {
+		s.Nil(timeline[4].Escalations[0].NextReceiver, "NextReceiver")
+	}
+	s.Empty(timeline[5].Escalations, "Escalations after silence")
+
+	failingEmail := *serverConfig.Notifyer.Receivers[1].EmailConfigs[0]
+	failingEmail.Text = `{{ template "missing" . }}`
+	serverConfig.Notifyer.Receivers[1].EmailConfigs = []*am_config.EmailConfig{&failingEmail}
+	simulator, err = notifyer.NewSimulator(ctx, serverConfig.Alerts, serverConfig.Notifyer)
+	s.NoError(err, "NewSimulator failing escalation")
+	_, err = simulator.Run(ctx, steps[:2])
+	s.NoError(err, "Run before failing escalation")
+	for _, step := range steps[2:4] {
+		entry, err := simulator.Step(ctx, step)
+		s.Error(err, "Step failing escalation")
+		s.Empty(entry.Notifications, "delivered groups are not notified again")
+		if s.Len(entry.Escalations, 1, "Escalations failing") {
+			s.Empty(entry.Escalations[0].EscalatedTo, "EscalatedTo failing")
+			s.Equal("devops", *entry.Escalations[0].NextReceiver, "NextReceiver failing")
+		}
+	}
+
+	serverConfig.Notifyer.Escalations[0].Steps = []configs.EscalationStepConfig{{Receiver: "unknown", DelaySec: 0}}
+	serverConfig.Notifyer.Escalations[1].RouteID = "{}/unknown"
+	s.Equal([]string{
+		"notifyer.escalations[0].steps[0].receiver",
+		"notifyer.escalations[0].steps[0].delaySec",
+		"notifyer.escalations[1].routeId",
+	}, configErrorPaths(notifyer.ValidateConfig(serverConfig.Alerts, serverConfig.Notifyer)), "ValidateConfig")
+	_, err = notifyer.NewSimulator(ctx, serverConfig.Alerts, serverConfig.Notifyer)
+	s.ErrorIs(err, notifyer.ErrUnknownRoute, "unknown route")
+}
@@ -0,0 +1,44 @@
+package test
+
+import (
+	"context"
+	"log/slog"
+	"net/url"
+
+	"github.com/pgillich/micro-server/pkg/logger"
+
+	"github.com/pgillich/mimir-multitenant_alertmanager/internal/buildinfo"
+)
+
+func (s *NotifyerSuite) TestAlertEvents() {
+	log := logger.GetLogger(buildinfo.BuildInfo.AppName(), slog.LevelDebug).With(logger.KeyTestCase, s.T().Name())
+	smtpServer := StartSmtpServer(log, "localhost:2526")
+	defer smtpServer.Close()
+
+	server := runTestServer(s.T(), s.newNotifyerServerConfig("2526"), newNotifyerTestConfig(),
+		[]string{"multitenant-alertmanager", "notifyer"}, log)
+	defer server.Cancel()
+
+	testRootUrl, err := url.JoinPath(server.TestServer.URL, "/notifyer/api/v2")
+	s.NoError(err, "testRootUrl")
+	clientCtx := logger.NewContext(context.Background(), log)
+	alerts := s.waitNotifyerAlerts(clientCtx, testRootUrl)
+
+	events := s.readAlertEvents(clientCtx, testRootUrl+"/alerts/events", "0")
+	s.Len(events, len(alerts), "all alerts are new")
+	for e, event := range events {
+		s.Equal(uint64(e+1), event.Id, "Id")
+		s.Empty(event.OldState, "OldState")
+		s.NotEmpty(event.NewState, "NewState")
+		s.Equal(event.Alert.Labels["tenant"], event.Tenant, "Tenant")
+	}
+
+	events = s.readAlertEvents(clientCtx, testRootUrl+"/alerts/events", "1")
+	s.Len(events, len(alerts)-1, "resumed after the first event")
+
+	events = s.readAlertEvents(clientCtx, testRootUrl+"/alerts/events?filter="+url.QueryEscape(`tenant="devops"`), "0")
+	s.NotEmpty(events, "filtered events")
+	for _, event := range events {
+		s.Equal("devops", event.Tenant, "Tenant")
+	}
+}
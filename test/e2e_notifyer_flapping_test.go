@@ -0,0 +1,121 @@
+package test
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	am_config "github.com/prometheus/alertmanager/config"
+
+	"github.com/pgillich/micro-server/pkg/logger"
+
+	"github.com/pgillich/mimir-multitenant_alertmanager/configs"
+	"github.com/pgillich/mimir-multitenant_alertmanager/internal/buildinfo"
+	notifyer "github.com/pgillich/mimir-multitenant_alertmanager/internal/notifyer"
+	notifyer_api "github.com/pgillich/mimir-multitenant_alertmanager/pkg/api/notifyer"
+)
+
+func (s *NotifyerSuite) TestFlapping() {
+	log := logger.GetLogger(buildinfo.BuildInfo.AppName(), slog.LevelDebug).With(logger.KeyTestCase, s.T().Name())
+	ctx := logger.NewContext(context.Background(), log)
+	serverConfig := s.newNotifyerServerConfig("2525")
+	serverConfig.Notifyer.Receivers[0].EmailConfigs[0].Headers = map[string]string{
+		"Subject": `{{ .Status }}{{ if .CommonAnnotations.flapping }} flapping{{ end }}`,
+	}
+	serverConfig.Notifyer.Route.Routes = []*am_config.Route{{
+		Receiver: "email",
+		Match:    map[string]string{"tenant": "devops"},
+	}}
+	serverConfig.Notifyer.RouteFlapDetection = []configs.RouteFlapDetectionConfig{{
+		RouteID: `{}/{tenant="devops"}/0`, Threshold: 3, WindowSec: 600,
+	}}
+
+	start := time.Date(2024, 12, 13, 19, 30, 0, 0, time.UTC)
+	alertAt := func(tenant string, at time.Duration) notifyer_api.GettableAlert {
+		return notifyer_api.GettableAlert{
+			Labels:   notifyer_api.LabelSet{"alertname": "Flapping", "tenant": tenant},
+			StartsAt: start.Add(at),
+			EndsAt:   start.Add(at + 30*time.Second),
+		}
+	}
+	steps := []notifyer.SimulationStep{}
+	for minute := range 7 {
+		at := time.Duration(minute) * time.Minute
+		alerts := notifyer_api.GettableAlerts{}
+		if minute%2 == 0 {
+			alerts = append(alerts, alertAt("devops", at), alertAt("app-development", at))
+		}
+		steps = append(steps, notifyer.SimulationStep{Time: start.Add(at), Alerts: alerts})
+	}
+	for _, minute := range []int{7, 12, 17} {
+		at := time.Duration(minute) * time.Minute
+		stable := alertAt("devops", at)
+		stable.EndsAt = start.Add(at + 10*time.Minute)
+		steps = append(steps, notifyer.SimulationStep{Time: start.Add(at), Alerts: notifyer_api.GettableAlerts{stable}})
+	}
+
+	simulator, err := notifyer.NewSimulator(ctx, serverConfig.Alerts, serverConfig.Notifyer)
+	s.NoError(err, "NewSimulator")
+	timeline, err := simulator.Run(ctx, steps)
+	s.NoError(err, "Run")
+
+	subjects := map[string][]string{}
+	for _, entry := range timeline {
+		for _, notification := range entry.Notifications {
+			for _, alert := range notification.Alerts {
+				subjects[alert["tenant"]] = append(subjects[alert["tenant"]],
+					entry.Time.Sub(start).String()+" "+notification.Subject)
+			}
+		}
+	}
+	s.Equal([]string{
+		"0s firing",
+		"1m0s resolved",
+		"2m0s firing",
+		"3m0s resolved",
+		"4m0s firing flapping",
+		"17m0s firing",
+	}, subjects["devops"], "devops")
+	s.Equal([]string{
+		"0s firing",
+		"1m0s resolved",
+		"2m0s firing",
+		"3m0s resolved",
+		"4m0s firing",
+		"5m0s resolved",
+		"6m0s firing",
+		"7m0s resolved",
+	}, subjects["app-development"], "app-development")
+
+	failingEmail := *serverConfig.Notifyer.Receivers[0].EmailConfigs[0]
+	failingEmail.Text = `{{ template "missing" . }}`
+	serverConfig.Notifyer.Receivers = append(serverConfig.Notifyer.Receivers,
+		am_config.Receiver{Name: "failing", EmailConfigs: []*am_config.EmailConfig{&failingEmail}})
+	serverConfig.Notifyer.Route.Routes = append(serverConfig.Notifyer.Route.Routes, &am_config.Route{
+		Receiver: "failing",
+		Match:    map[string]string{"tenant": "app-development"},
+	})
+	simulator, err = notifyer.NewSimulator(ctx, serverConfig.Alerts, serverConfig.Notifyer)
+	s.NoError(err, "NewSimulator failing receiver")
+	notified := []string{}
+	stableAlerts := notifyer_api.GettableAlerts{alertAt("devops", 0), alertAt("app-development", 0)}
+	for a := range stableAlerts {
+		stableAlerts[a].EndsAt = start.Add(10 * time.Minute)
+	}
+	for minute := range 4 {
+		entry, err := simulator.Step(ctx, notifyer.SimulationStep{
+			Time: start.Add(time.Duration(minute) * time.Minute), Alerts: stableAlerts,
+		})
+		s.Error(err, "Step failing receiver")
+		for _, notification := range entry.Notifications {
+			notified = append(notified, entry.Time.Sub(start).String()+" "+notification.Subject)
+		}
+	}
+	s.Equal([]string{"0s firing"}, notified, "delivered group not notified again, not flapping")
+
+	serverConfig.Notifyer.RouteFlapDetection[0].RouteID = "{}/unknown"
+	_, err = notifyer.NewSimulator(ctx, serverConfig.Alerts, serverConfig.Notifyer)
+	s.ErrorIs(err, notifyer.ErrUnknownRoute, "unknown route")
+	s.Equal([]string{"notifyer.routeFlapDetection[0].routeId"},
+		configErrorPaths(notifyer.ValidateConfig(serverConfig.Alerts, serverConfig.Notifyer)), "ValidateConfig")
+}
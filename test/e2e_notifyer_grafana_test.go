@@ -0,0 +1,85 @@
+package test
+
+import (
+	"context"
+	"log/slog"
+	"os"
+	"time"
+
+	am_config "github.com/prometheus/alertmanager/config"
+
+	"github.com/pgillich/micro-server/pkg/logger"
+
+	"github.com/pgillich/mimir-multitenant_alertmanager/internal/buildinfo"
+	notifyer "github.com/pgillich/mimir-multitenant_alertmanager/internal/notifyer"
+	notifyer_api "github.com/pgillich/mimir-multitenant_alertmanager/pkg/api/notifyer"
+)
+
+func (s *NotifyerSuite) TestGrafanaTemplateData() {
+	log := logger.GetLogger(buildinfo.BuildInfo.AppName(), slog.LevelDebug).With(logger.KeyTestCase, s.T().Name())
+	ctx := logger.NewContext(context.Background(), log)
+	htmlTmpl, err := os.ReadFile("../testdata/notifier/ng_alert_notification.html")
+	s.NoError(err, "ng_alert_notification.html")
+	serverConfig := s.newNotifyerServerConfig("2525")
+	serverConfig.Alerts.ActionLinkSecret = "secret"
+	serverConfig.Notifyer.GrafanaURL = "http://grafana.local/"
+	serverConfig.Notifyer.GrafanaReceivers = []string{"email"}
+	emailConfig := serverConfig.Notifyer.Receivers[0].EmailConfigs[0]
+	standardConfig := *emailConfig
+	standardConfig.Headers = map[string]string{"Subject": `{{ index .CommonLabels "__alert_rule_uid__" }} {{ .Status }}`}
+	emailConfig.HTML = string(htmlTmpl)
+	emailConfig.Text = `{{ range .Alerts }}Summary: {{ .Annotations.summary }} {{ .Labels }} {{ .Annotations }}{{ end }}
+Go to the Alerts page: {{ .AlertPageUrl }}
+Sent by {{ .SentBy }}`
+	emailConfig.Headers["Subject"] = `{{ range .Alerts }}{{ .ValueString }} {{ .OrgID }}{{ end }}`
+	// the other receivers get the Alertmanager template data, with the private labels
+	serverConfig.Notifyer.Receivers = append(serverConfig.Notifyer.Receivers, am_config.Receiver{
+		Name: "standard", EmailConfigs: []*am_config.EmailConfig{&standardConfig},
+	})
+	serverConfig.Notifyer.Route.Routes = []*am_config.Route{
+		{Receiver: "standard", Match: map[string]string{"tenant": "devops"}, Continue: true},
+		{Receiver: "email", Match: map[string]string{"tenant": "devops"}},
+	}
+	s.Empty(notifyer.ValidateConfig(serverConfig.Alerts, serverConfig.Notifyer), "ValidateConfig")
+
+	start := time.Now()
+	simulator, err := notifyer.NewSimulator(ctx, serverConfig.Alerts, serverConfig.Notifyer)
+	s.NoError(err, "NewSimulator")
+	entry, err := simulator.Step(ctx, notifyer.SimulationStep{Time: start, Alerts: notifyer_api.GettableAlerts{{
+		Labels: notifyer_api.LabelSet{"alertname": "Grafana", "tenant": "devops", "__alert_rule_uid__": "rule-uid"},
+		Annotations: notifyer_api.LabelSet{
+			"summary":          "High CPU",
+			"__value_string__": "[ var='B' labels={} value=42.5 ]",
+			"__values__":       `{"B":42.5}`,
+			"__dashboardUid__": "dash-uid",
+			"__panelId__":      "3",
+			"__orgId__":        "1",
+		},
+		EndsAt: start.Add(time.Hour),
+	}}})
+	s.NoError(err, "Step")
+
+	notifications := map[string]notifyer_api.DryRunNotification{}
+	for _, notification := range entry.Notifications {
+		notifications[notification.Receiver] = notification
+	}
+	s.Len(notifications, 2, "notifications")
+	s.Equal("rule-uid firing", notifications["standard"].Subject, "standard Subject")
+	if notification, has := notifications["email"]; s.True(has, "Grafana notification") {
+		s.Equal("[ var='B' labels={} value=42.5 ] 1", notification.Subject, "Subject")
+		s.Contains(notification.Html, "<title>\n    [ var=&amp;#39;B&amp;#39; labels={} value=42.5 ] 1\n  </title>", "Html title")
+		s.Contains(notification.Html, "B=42.5", "Html values")
+		s.Contains(notification.Html, `href="http://grafana.local/d/dash-uid?orgId=1"`, "Html dashboard")
+		s.Contains(notification.Html, `href="http://grafana.local/d/dash-uid?orgId=1&amp;viewPanel=3"`, "Html panel")
+		s.Contains(notification.Html, `href="http://ExternalURL/multitenant-alertmanager/api/v2/actions/silence?duration=2h`, "Html silence")
+		s.Contains(notification.Text, "Summary: High CPU", "Text")
+		s.Contains(notification.Text, "Go to the Alerts page: http://ExternalURL/", "Text")
+		s.Contains(notification.Text, "Sent by "+buildinfo.BuildInfo.AppName(), "Text")
+		s.NotContains(notification.Text, "__", "private labels and annotations")
+	}
+
+	serverConfig.Notifyer.GrafanaURL = "grafana"
+	serverConfig.Notifyer.GrafanaReceivers = []string{"unknown"}
+	s.Equal([]string{"notifyer.grafanaUrl", "notifyer.grafanaReceivers[0]"},
+		configErrorPaths(notifyer.ValidateConfig(serverConfig.Alerts, serverConfig.Notifyer)), "ValidateConfig")
+}
@@ -0,0 +1,124 @@
+package test
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"net/http"
+	"os"
+	"strings"
+	"time"
+
+	am_config "github.com/prometheus/alertmanager/config"
+
+	mw_client "github.com/pgillich/micro-server/pkg/middleware/client"
+	mw_client_model "github.com/pgillich/micro-server/pkg/middleware/client/model"
+	srv_utils "github.com/pgillich/micro-server/pkg/utils"
+
+	"github.com/pgillich/mimir-multitenant_alertmanager/configs"
+	notifyer_api "github.com/pgillich/mimir-multitenant_alertmanager/pkg/api/notifyer"
+)
+
+// newNotifyerServerConfig returns a server config with one email receiver, sending to the test SMTP server
+func (s *NotifyerSuite) newNotifyerServerConfig(smtpPort string) *configs.ServerConfig {
+	defaultTmpl, err := os.ReadFile("../testdata/notifier/default.tmpl")
+	s.NoError(err, "default.tmpl")
+	emailTmpl, err := os.ReadFile("../testdata/notifier/email.tmpl")
+	s.NoError(err, "email.tmpl")
+	extensionTmpl, err := os.ReadFile("../testdata/notifier/extension.tmpl")
+	s.NoError(err, "extension.tmpl")
+	txtTmpl, err := os.ReadFile("../testdata/notifier/ng_alert_notification.txt")
+	s.NoError(err, "ng_alert_notification.txt")
+	emailRequireTLS := false
+
+	return &configs.ServerConfig{
+		Alerts: &configs.AlertsConfig{
+			AlertmanagerUrl: "http://localhost:8085/alertmanager/api/v2",
+			Tenants:         []string{"devops", "app-development"},
+			TenantLabel:     "tenant",
+		},
+		Notifyer: &configs.NotifyerConfig{
+			ExternalURL:   "http://ExternalURL",
+			PollPeriodSec: 600,
+			Route: &am_config.Route{
+				Receiver: "email",
+			},
+			Receivers: []am_config.Receiver{
+				{
+					Name: "email",
+					EmailConfigs: []*am_config.EmailConfig{
+						{
+							Smarthost:    am_config.HostPort{Host: "localhost", Port: smtpPort},
+							To:           "testuser@localhost",
+							From:         "notifier@e2e.test",
+							AuthUsername: "testuser",
+							AuthPassword: "testpass",
+							RequireTLS:   &emailRequireTLS,
+							Text:         string(txtTmpl),
+							Headers:      map[string]string{},
+						},
+					},
+				},
+			},
+			Templates: []string{
+				string(defaultTmpl),
+				string(emailTmpl),
+				string(extensionTmpl),
+			},
+		},
+	}
+}
+
+func newNotifyerTestConfig() *configs.TestConfig {
+	return &configs.TestConfig{
+		CaptureTransportMode: mw_client_model.CaptureTransportModeFake,
+		CaptureDir:           "../testdata/capture",
+		CaptureMatchers: []mw_client_model.CaptureMatcher{
+			mw_client.CaptureEqualRequestURLAndHeader(configs.HttpHeaderXscopeorgid),
+		},
+		NotifierStartEvalImmediately: true,
+	}
+}
+
+// waitNotifyerAlerts waits for the first evaluation of the notifyer
+func (s *NotifyerSuite) waitNotifyerAlerts(ctx context.Context, rootUrl string) notifyer_api.GettableAlerts {
+	client, err := notifyer_api.NewClientWithResponses(rootUrl, notifyer_api.WithHTTPClient(srv_utils.NewHttpClient()))
+	s.NoError(err, "notifyer_api.NewClientWithResponses")
+	for range 50 {
+		resp, err := client.GetAlertsWithResponse(ctx, &notifyer_api.GetAlertsParams{})
+		s.NoError(err, "GetAlertsWithResponse")
+		if resp.JSON200 != nil && len(*resp.JSON200) > 0 {
+			return *resp.JSON200
+		}
+		time.Sleep(100 * time.Millisecond)
+	}
+	s.Fail("notifyer alerts not found")
+
+	return notifyer_api.GettableAlerts{}
+}
+
+// readAlertEvents reads the alert events, until the stream is idle
+func (s *NotifyerSuite) readAlertEvents(ctx context.Context, eventsUrl string, lastEventID string) []notifyer_api.AlertEvent {
+	ctx, cancel := context.WithTimeout(ctx, 2*time.Second)
+	defer cancel()
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, eventsUrl, http.NoBody)
+	s.NoError(err, "NewRequestWithContext")
+	req.Header.Set("Last-Event-ID", lastEventID)
+	resp, err := srv_utils.NewHttpClient().Do(req)
+	s.NoError(err, "Do")
+	defer resp.Body.Close()
+	s.Equal(http.StatusOK, resp.StatusCode, "StatusCode")
+	s.Equal("text/event-stream", resp.Header.Get("Content-Type"), "Content-Type")
+
+	events := []notifyer_api.AlertEvent{}
+	scanner := bufio.NewScanner(resp.Body)
+	for scanner.Scan() {
+		if data, found := strings.CutPrefix(scanner.Text(), "data: "); found {
+			event := notifyer_api.AlertEvent{}
+			s.NoError(json.Unmarshal([]byte(data), &event), "Unmarshal")
+			events = append(events, event)
+		}
+	}
+
+	return events
+}
@@ -0,0 +1,126 @@
+package test
+
+import (
+	"context"
+	"log/slog"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/prometheus/alertmanager/pkg/labels"
+
+	"github.com/pgillich/micro-server/pkg/logger"
+	srv_utils "github.com/pgillich/micro-server/pkg/utils"
+
+	"github.com/pgillich/mimir-multitenant_alertmanager/internal/buildinfo"
+	notifyer "github.com/pgillich/mimir-multitenant_alertmanager/internal/notifyer"
+	notifyer_api "github.com/pgillich/mimir-multitenant_alertmanager/pkg/api/notifyer"
+)
+
+func (s *NotifyerSuite) TestAlertHistory() {
+	log := logger.GetLogger(buildinfo.BuildInfo.AppName(), slog.LevelDebug).With(logger.KeyTestCase, s.T().Name())
+	smtpServer := StartSmtpServer(log, "localhost:2530")
+	defer smtpServer.Close()
+
+	server := runTestServer(s.T(), s.newNotifyerServerConfig("2530"), newNotifyerTestConfig(),
+		[]string{"multitenant-alertmanager", "notifyer"}, log)
+	defer server.Cancel()
+
+	testRootUrl, err := url.JoinPath(server.TestServer.URL, "/notifyer/api/v2")
+	s.NoError(err, "testRootUrl")
+	clientCtx := logger.NewContext(context.Background(), log)
+	alerts := s.waitNotifyerAlerts(clientCtx, testRootUrl)
+	client, err := notifyer_api.NewClientWithResponses(testRootUrl, notifyer_api.WithHTTPClient(srv_utils.NewHttpClient()))
+	s.NoError(err, "notifyer_api.NewClientWithResponses")
+
+	var history *notifyer_api.AlertHistory
+	for range 50 {
+		resp, err := client.GetAlertHistoryWithResponse(clientCtx, &notifyer_api.GetAlertHistoryParams{})
+		s.NoError(err, "GetAlertHistoryWithResponse")
+		s.Equal(http.StatusOK, resp.StatusCode(), "StatusCode")
+		if history = resp.JSON200; history != nil && len(history.Transitions) > 0 {
+			break
+		}
+		time.Sleep(100 * time.Millisecond)
+	}
+	if !s.NotNil(history, "history") || !s.Len(history.Transitions, len(alerts), "Transitions") {
+		return
+	}
+	firing := 0
+	for _, alert := range alerts {
+		if notifyer.AlertState(alert) == string(notifyer_api.Active) {
+			firing++
+		}
+	}
+	s.Len(history.Intervals, firing, "Intervals")
+	for _, interval := range history.Intervals {
+		s.Nil(interval.End, "End")
+	}
+
+	filter := []string{`alertname="` + alerts[0].Labels["alertname"] + `"`, `tenant="` + alerts[0].Labels["tenant"] + `"`}
+	resp, err := client.GetAlertHistoryWithResponse(clientCtx, &notifyer_api.GetAlertHistoryParams{Filter: &filter})
+	s.NoError(err, "GetAlertHistoryWithResponse")
+	if s.NotNil(resp.JSON200, "JSON200") && s.NotEmpty(resp.JSON200.Transitions, "Transitions") {
+		s.Equal(alerts[0].Labels, resp.JSON200.Transitions[0].Labels, "Labels")
+		s.Equal("", resp.JSON200.Transitions[0].OldState, "OldState")
+	}
+
+	end := time.Now().Add(-time.Hour)
+	resp, err = client.GetAlertHistoryWithResponse(clientCtx, &notifyer_api.GetAlertHistoryParams{End: &end})
+	s.NoError(err, "GetAlertHistoryWithResponse")
+	if s.NotNil(resp.JSON200, "JSON200") {
+		s.Empty(resp.JSON200.Transitions, "Transitions")
+	}
+	start := time.Now()
+	resp, err = client.GetAlertHistoryWithResponse(clientCtx, &notifyer_api.GetAlertHistoryParams{Start: &start, End: &end})
+	s.NoError(err, "GetAlertHistoryWithResponse")
+	s.Equal(http.StatusBadRequest, resp.StatusCode(), "StatusCode")
+}
+
+func (s *NotifyerSuite) TestHistoryStore() {
+	historyPath := filepath.Join(s.T().TempDir(), "history.jsonl")
+	start := time.Date(2024, 12, 13, 19, 30, 0, 0, time.UTC)
+	alert := notifyer_api.GettableAlert{
+		Fingerprint: "1",
+		Labels:      notifyer_api.LabelSet{"alertname": "HighLatency", "tenant": "devops"},
+	}
+	event := func(at time.Duration, oldState string, newState string) notifyer_api.AlertEvent {
+		return notifyer_api.AlertEvent{Time: start.Add(at), Tenant: "devops", Fingerprint: "1", OldState: oldState, NewState: newState, Alert: alert}
+	}
+
+	history, err := notifyer.NewHistoryStore(historyPath, time.Hour, start)
+	s.NoError(err, "NewHistoryStore")
+	s.NoError(history.Record(start, event(0, "", "active")), "Record")
+	s.NoError(history.Record(start.Add(10*time.Minute), event(10*time.Minute, "active", "suppressed")), "Record")
+	s.NoError(history.Record(start.Add(20*time.Minute), event(20*time.Minute, "suppressed", "active")), "Record")
+	s.NoError(history.Record(start.Add(30*time.Minute), event(30*time.Minute, "active", "resolved")), "Record")
+
+	history, err = notifyer.NewHistoryStore(historyPath, time.Hour, start.Add(30*time.Minute))
+	s.NoError(err, "NewHistoryStore reload")
+	result := history.Query(labels.Matchers{}, time.Time{}, start.Add(time.Hour))
+	s.Len(result.Transitions, 4, "Transitions")
+	if s.Len(result.Intervals, 2, "Intervals") {
+		s.Equal(start, result.Intervals[0].Start, "Start")
+		s.Equal(start.Add(10*time.Minute), *result.Intervals[0].End, "End")
+		s.Equal(start.Add(20*time.Minute), result.Intervals[1].Start, "Start")
+		s.Equal(start.Add(30*time.Minute), *result.Intervals[1].End, "End")
+	}
+
+	result = history.Query(labels.Matchers{}, start.Add(15*time.Minute), start.Add(time.Hour))
+	s.Len(result.Transitions, 2, "Transitions in range")
+	s.Len(result.Intervals, 1, "Intervals in range")
+
+	matcher, err := labels.NewMatcher(labels.MatchEqual, "tenant", "app-development")
+	s.NoError(err, "NewMatcher")
+	s.Empty(history.Query(labels.Matchers{matcher}, time.Time{}, start.Add(time.Hour)).Transitions, "Transitions of other tenant")
+
+	history, err = notifyer.NewHistoryStore(historyPath, time.Hour, start.Add(75*time.Minute))
+	s.NoError(err, "NewHistoryStore expired")
+	s.Len(history.Query(labels.Matchers{}, time.Time{}, start.Add(2*time.Hour)).Transitions, 2, "Transitions after retention")
+	content, err := os.ReadFile(historyPath)
+	s.NoError(err, "ReadFile")
+	s.Equal(2, strings.Count(string(content), "\n"), "compacted history file")
+}
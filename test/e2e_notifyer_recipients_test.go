@@ -0,0 +1,94 @@
+package test
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	am_config "github.com/prometheus/alertmanager/config"
+
+	"github.com/pgillich/micro-server/pkg/logger"
+
+	"github.com/pgillich/mimir-multitenant_alertmanager/configs"
+	"github.com/pgillich/mimir-multitenant_alertmanager/internal/buildinfo"
+	notifyer "github.com/pgillich/mimir-multitenant_alertmanager/internal/notifyer"
+	notifyer_api "github.com/pgillich/mimir-multitenant_alertmanager/pkg/api/notifyer"
+)
+
+func (s *NotifyerSuite) TestDynamicRecipients() {
+	log := logger.GetLogger(buildinfo.BuildInfo.AppName(), slog.LevelDebug).With(logger.KeyTestCase, s.T().Name())
+	ctx := logger.NewContext(context.Background(), log)
+	serverConfig := s.newNotifyerServerConfig("2525")
+	emailConfig := *serverConfig.Notifyer.Receivers[0].EmailConfigs[0]
+	emailConfig.To = "devops@localhost"
+	serverConfig.Notifyer.Receivers = append(serverConfig.Notifyer.Receivers[:1], am_config.Receiver{
+		Name: "devops", EmailConfigs: []*am_config.EmailConfig{&emailConfig},
+	})
+	serverConfig.Notifyer.Route.Routes = []*am_config.Route{{
+		Receiver: "devops", Match: map[string]string{"tenant": "devops"}, GroupByStr: []string{"alertname"},
+	}}
+	serverConfig.Notifyer.Recipients = []configs.RecipientsConfig{{
+		Receiver:         "devops",
+		To:               "{{ .CommonLabels.owner_email }}, {{ .CommonAnnotations.escalation_email }}",
+		Cc:               "{{ .CommonLabels.team }}@example.com, {{ .CommonLabels.owner_email }}; oncall@sub.example.com",
+		AllowedDomains:   []string{"example.com"},
+		FallbackReceiver: "email",
+	}}
+	s.Empty(notifyer.ValidateConfig(serverConfig.Alerts, serverConfig.Notifyer), "ValidateConfig")
+
+	start := time.Now()
+	alert := func(alertname string, ownerEmail string, escalationEmail string) notifyer_api.GettableAlert {
+		return notifyer_api.GettableAlert{
+			Labels:      notifyer_api.LabelSet{"alertname": alertname, "tenant": "devops", "owner_email": ownerEmail, "team": "sre"},
+			Annotations: notifyer_api.LabelSet{"escalation_email": escalationEmail},
+			EndsAt:      start.Add(time.Hour),
+		}
+	}
+	simulator, err := notifyer.NewSimulator(ctx, serverConfig.Alerts, serverConfig.Notifyer)
+	s.NoError(err, "NewSimulator")
+	entry, err := simulator.Step(ctx, notifyer.SimulationStep{Time: start, Alerts: notifyer_api.GettableAlerts{
+		alert("Owned", "Jane.Doe@Example.com", "jane.doe@example.com"), alert("Foreign", "jdoe@example.org", ""),
+	}})
+	s.NoError(err, "Step")
+
+	notifications := []string{}
+	for _, notification := range entry.Notifications {
+		cc := ""
+		if notification.Cc != nil {
+			cc = *notification.Cc
+		}
+		notifications = append(notifications, notification.Receiver+" to="+notification.To+" cc="+cc)
+	}
+	s.Equal([]string{
+		"email to=testuser@localhost cc=",
+		"devops to=jane.doe@example.com cc=sre@example.com, oncall@sub.example.com",
+	}, notifications, "notifications")
+
+	// the domains of the static addresses are not checked
+	serverConfig.Notifyer.Recipients = []configs.RecipientsConfig{{Receiver: "devops", To: "Ops@Example.org"}}
+	s.Empty(notifyer.ValidateConfig(serverConfig.Alerts, serverConfig.Notifyer), "ValidateConfig static")
+	simulator, err = notifyer.NewSimulator(ctx, serverConfig.Alerts, serverConfig.Notifyer)
+	s.NoError(err, "NewSimulator static")
+	entry, err = simulator.Step(ctx, notifyer.SimulationStep{Time: start, Alerts: notifyer_api.GettableAlerts{
+		alert("Owned", "Jane.Doe@Example.com", "jane.doe@example.com"),
+	}})
+	s.NoError(err, "Step static")
+	s.Len(entry.Notifications, 1, "static notifications")
+	for _, notification := range entry.Notifications {
+		s.Equal("devops ops@example.org", notification.Receiver+" "+notification.To, "static notification")
+	}
+
+	serverConfig.Notifyer.Recipients = []configs.RecipientsConfig{
+		{Receiver: "devops", Cc: "{{ .CommonLabels.team", AllowedDomains: []string{"@example.com"}, FallbackReceiver: "devops"},
+		{Receiver: "unknown", To: "{{ .CommonLabels.owner_email }}", FallbackReceiver: "unknown"},
+	}
+	s.Equal([]string{
+		"notifyer.recipients[0].to",
+		"notifyer.recipients[0].allowedDomains[0]",
+		"notifyer.recipients[0].fallbackReceiver",
+		"notifyer.recipients[1].receiver",
+		"notifyer.recipients[1].allowedDomains",
+		"notifyer.recipients[1].fallbackReceiver",
+		"notifyer.recipients[0].cc",
+	}, configErrorPaths(notifyer.ValidateConfig(serverConfig.Alerts, serverConfig.Notifyer)), "ValidateConfig")
+}
@@ -0,0 +1,103 @@
+package test
+
+import (
+	"context"
+	"log/slog"
+	"net/http"
+	"net/url"
+
+	"github.com/pgillich/micro-server/pkg/logger"
+	srv_utils "github.com/pgillich/micro-server/pkg/utils"
+
+	"github.com/pgillich/mimir-multitenant_alertmanager/internal/buildinfo"
+	notifyer "github.com/pgillich/mimir-multitenant_alertmanager/internal/notifyer"
+	notifyer_api "github.com/pgillich/mimir-multitenant_alertmanager/pkg/api/notifyer"
+)
+
+func (s *NotifyerSuite) TestRenderTemplates() {
+	log := logger.GetLogger(buildinfo.BuildInfo.AppName(), slog.LevelDebug).With(logger.KeyTestCase, s.T().Name())
+	smtpServer := StartSmtpServer(log, "localhost:2527")
+	defer smtpServer.Close()
+
+	server := runTestServer(s.T(), s.newNotifyerServerConfig("2527"), newNotifyerTestConfig(),
+		[]string{"multitenant-alertmanager", "notifyer"}, log)
+	defer server.Cancel()
+
+	testRootUrl, err := url.JoinPath(server.TestServer.URL, "/notifyer/api/v2")
+	s.NoError(err, "testRootUrl")
+	clientCtx := logger.NewContext(context.Background(), log)
+	liveAlerts := s.waitNotifyerAlerts(clientCtx, testRootUrl)
+	client, err := notifyer_api.NewClientWithResponses(testRootUrl, notifyer_api.WithHTTPClient(srv_utils.NewHttpClient()))
+	s.NoError(err, "notifyer_api.NewClientWithResponses")
+
+	// Configured templates and live alerts
+	resp, err := client.RenderTemplatesWithResponse(clientCtx, notifyer_api.TemplateRenderRequest{})
+	s.NoError(err, "RenderTemplatesWithResponse")
+	s.Equal(http.StatusOK, resp.StatusCode(), "StatusCode")
+	if s.NotNil(resp.JSON200, "JSON200") {
+		s.Equal("email", resp.JSON200.Receiver, "Receiver")
+		s.Contains(resp.JSON200.Subject, "[FIRING:", "Subject")
+		s.Contains(resp.JSON200.Text, "FIRING", "Text")
+		s.Contains(resp.JSON200.Text, liveAlerts[0].Labels["alertname"], "Text")
+		s.Empty(resp.JSON200.Html, "Html")
+	}
+
+	// Request templates and alerts
+	templates := []notifyer_api.TemplateSource{{
+		Name:    "custom.tmpl",
+		Content: `{{ define "custom.subject" }}{{ .Alerts | len }} alert for {{ .GroupLabels.alertname }}{{ end }}`,
+	}}
+	subject := `{{ template "custom.subject" . }}`
+	text := "{{ range .Alerts }}{{ .Labels.alertname }}/{{ .Labels.tenant }}\n{{ end }}"
+	html := "<b>{{ .Receiver }}</b>"
+	groupLabels := notifyer_api.LabelSet{"alertname": "TestAlert"}
+	alerts := notifyer_api.GettableAlerts{liveAlerts[0]}
+	resp, err = client.RenderTemplatesWithResponse(clientCtx, notifyer_api.TemplateRenderRequest{
+		Templates: &templates, Subject: &subject, Text: &text, Html: &html, GroupLabels: &groupLabels, Alerts: &alerts,
+	})
+	s.NoError(err, "RenderTemplatesWithResponse")
+	s.Equal(http.StatusOK, resp.StatusCode(), "StatusCode")
+	if s.NotNil(resp.JSON200, "JSON200") {
+		s.Equal("1 alert for TestAlert", resp.JSON200.Subject, "Subject")
+		s.Equal(liveAlerts[0].Labels["alertname"]+"/"+liveAlerts[0].Labels["tenant"]+"\n", resp.JSON200.Text, "Text")
+		s.Equal("<b>email</b>", resp.JSON200.Html, "Html")
+	}
+
+	// Template errors
+	templates = []notifyer_api.TemplateSource{{
+		Name:    "broken.tmpl",
+		Content: "{{ define \"broken\" }}\n{{ .Foo | nofunc }}\n{{ end }}",
+	}}
+	resp, err = client.RenderTemplatesWithResponse(clientCtx, notifyer_api.TemplateRenderRequest{
+		Templates: &templates, Alerts: &alerts,
+	})
+	s.NoError(err, "RenderTemplatesWithResponse")
+	s.Equal(http.StatusBadRequest, resp.StatusCode(), "StatusCode")
+	if s.NotNil(resp.JSON400, "JSON400") && s.Len(*resp.JSON400, 1, "JSON400") {
+		templateError := (*resp.JSON400)[0]
+		s.Equal("broken.tmpl", templateError.Template, "Template")
+		if s.NotNil(templateError.Line, "Line") {
+			s.Equal(2, *templateError.Line, "Line")
+		}
+		s.Contains(templateError.Message, `"nofunc" not defined`, "Message")
+	}
+
+	text = "{{ .Alerts.Foo }}"
+	resp, err = client.RenderTemplatesWithResponse(clientCtx, notifyer_api.TemplateRenderRequest{
+		Text: &text, Alerts: &alerts,
+	})
+	s.NoError(err, "RenderTemplatesWithResponse")
+	s.Equal(http.StatusBadRequest, resp.StatusCode(), "StatusCode")
+	if s.NotNil(resp.JSON400, "JSON400") && s.Len(*resp.JSON400, 1, "JSON400") {
+		templateError := (*resp.JSON400)[0]
+		s.Equal("text", templateError.Template, "Template")
+		if s.NotNil(templateError.Column, "Column") {
+			s.Equal(10, *templateError.Column, "Column")
+		}
+	}
+
+	serverConfig := s.newNotifyerServerConfig("2527")
+	serverConfig.Notifyer.Route = nil
+	_, _, err = notifyer.RenderTemplates(clientCtx, serverConfig.Alerts, serverConfig.Notifyer, notifyer_api.TemplateRenderRequest{}, alerts)
+	s.ErrorIs(err, notifyer.ErrMissingRoute, "RenderTemplates without route")
+}
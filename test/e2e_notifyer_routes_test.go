@@ -0,0 +1,110 @@
+package test
+
+import (
+	"context"
+	"log/slog"
+	"net/http"
+	"net/url"
+	"time"
+
+	am_config "github.com/prometheus/alertmanager/config"
+	prom_model "github.com/prometheus/common/model"
+
+	"github.com/pgillich/micro-server/pkg/logger"
+	srv_utils "github.com/pgillich/micro-server/pkg/utils"
+
+	"github.com/pgillich/mimir-multitenant_alertmanager/internal/buildinfo"
+	notifyer_api "github.com/pgillich/mimir-multitenant_alertmanager/pkg/api/notifyer"
+)
+
+func (s *NotifyerSuite) TestRoutes() {
+	log := logger.GetLogger(buildinfo.BuildInfo.AppName(), slog.LevelDebug).With(logger.KeyTestCase, s.T().Name())
+	smtpServer := StartSmtpServer(log, "localhost:2528")
+	defer smtpServer.Close()
+
+	serverConfig := s.newNotifyerServerConfig("2528")
+	repeatInterval := prom_model.Duration(time.Hour)
+	serverConfig.Notifyer.Receivers = append(serverConfig.Notifyer.Receivers[:1],
+		am_config.Receiver{Name: "devops"}, am_config.Receiver{Name: "critical"})
+	serverConfig.Notifyer.Route.GroupByStr = []string{"alertname", "tenant"}
+	serverConfig.Notifyer.Route.Routes = []*am_config.Route{
+		{
+			Receiver: "devops",
+			Match:    map[string]string{"tenant": "devops"},
+			Continue: true,
+		},
+		{
+			Receiver:       "critical",
+			Match:          map[string]string{"severity": "critical"},
+			GroupByStr:     []string{"..."},
+			RepeatInterval: &repeatInterval,
+		},
+	}
+
+	server := runTestServer(s.T(), serverConfig, newNotifyerTestConfig(),
+		[]string{"multitenant-alertmanager", "notifyer"}, log)
+	defer server.Cancel()
+
+	testRootUrl, err := url.JoinPath(server.TestServer.URL, "/notifyer/api/v2")
+	s.NoError(err, "testRootUrl")
+	clientCtx := logger.NewContext(context.Background(), log)
+	liveAlerts := s.waitNotifyerAlerts(clientCtx, testRootUrl)
+	client, err := notifyer_api.NewClientWithResponses(testRootUrl, notifyer_api.WithHTTPClient(srv_utils.NewHttpClient()))
+	s.NoError(err, "notifyer_api.NewClientWithResponses")
+
+	treeResp, err := client.GetRoutesWithResponse(clientCtx)
+	s.NoError(err, "GetRoutesWithResponse")
+	s.Equal(http.StatusOK, treeResp.StatusCode(), "StatusCode")
+	if s.NotNil(treeResp.JSON200, "JSON200") {
+		s.Equal("email", treeResp.JSON200.Receiver, "Receiver")
+		s.Equal([]string{"alertname", "tenant"}, treeResp.JSON200.GroupBy, "GroupBy")
+		s.Equal("30s", treeResp.JSON200.GroupWait, "GroupWait")
+		if s.Len(treeResp.JSON200.Routes, 2, "Routes") {
+			s.Equal([]string{`tenant="devops"`}, treeResp.JSON200.Routes[0].Matchers, "Matchers")
+			s.True(treeResp.JSON200.Routes[0].Continue, "Continue")
+			s.True(treeResp.JSON200.Routes[1].GroupByAll, "GroupByAll")
+			s.Equal("1h", treeResp.JSON200.Routes[1].RepeatInterval, "RepeatInterval")
+		}
+	}
+
+	labels := notifyer_api.LabelSet{"alertname": "Test", "tenant": "devops", "severity": "critical"}
+	resp, err := client.TestRoutesWithResponse(clientCtx, notifyer_api.RouteTestRequest{Labels: &labels})
+	s.NoError(err, "TestRoutesWithResponse")
+	s.Equal(http.StatusOK, resp.StatusCode(), "StatusCode")
+	if s.NotNil(resp.JSON200, "JSON200") && s.Len(resp.JSON200.Matches, 2, "Matches") {
+		devops := resp.JSON200.Matches[0]
+		s.Equal("devops", devops.Receiver, "Receiver")
+		s.Equal([]string{"{}", `{tenant="devops"}`}, devops.Path, "Path")
+		s.Equal(`{}/{tenant="devops"}:{alertname="Test", tenant="devops"}`, devops.GroupKey, "GroupKey")
+		s.Equal("4h", devops.RepeatInterval, "RepeatInterval")
+		critical := resp.JSON200.Matches[1]
+		s.Equal("critical", critical.Receiver, "Receiver")
+		s.Equal(labels, critical.GroupLabels, "GroupLabels")
+		s.Equal("1h", critical.RepeatInterval, "RepeatInterval")
+	}
+
+	labels = notifyer_api.LabelSet{"alertname": "Test", "tenant": "app-development"}
+	resp, err = client.TestRoutesWithResponse(clientCtx, notifyer_api.RouteTestRequest{Labels: &labels})
+	s.NoError(err, "TestRoutesWithResponse")
+	if s.NotNil(resp.JSON200, "JSON200") && s.Len(resp.JSON200.Matches, 1, "Matches") {
+		s.Equal("email", resp.JSON200.Matches[0].Receiver, "Receiver")
+		s.Equal([]string{"{}"}, resp.JSON200.Matches[0].Path, "Path")
+	}
+
+	resp, err = client.TestRoutesWithResponse(clientCtx, notifyer_api.RouteTestRequest{Fingerprint: &liveAlerts[0].Fingerprint})
+	s.NoError(err, "TestRoutesWithResponse")
+	s.Equal(http.StatusOK, resp.StatusCode(), "StatusCode")
+	if s.NotNil(resp.JSON200, "JSON200") {
+		s.Equal(liveAlerts[0].Labels, resp.JSON200.Labels, "Labels")
+		s.NotEmpty(resp.JSON200.Matches, "Matches")
+	}
+
+	unknown := "unknown"
+	resp, err = client.TestRoutesWithResponse(clientCtx, notifyer_api.RouteTestRequest{Fingerprint: &unknown})
+	s.NoError(err, "TestRoutesWithResponse")
+	s.Equal(http.StatusNotFound, resp.StatusCode(), "StatusCode")
+
+	resp, err = client.TestRoutesWithResponse(clientCtx, notifyer_api.RouteTestRequest{})
+	s.NoError(err, "TestRoutesWithResponse")
+	s.Equal(http.StatusBadRequest, resp.StatusCode(), "StatusCode")
+}
@@ -0,0 +1,67 @@
+package test
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	"github.com/pgillich/micro-server/pkg/logger"
+
+	"github.com/pgillich/mimir-multitenant_alertmanager/internal/buildinfo"
+	notifyer "github.com/pgillich/mimir-multitenant_alertmanager/internal/notifyer"
+	notifyer_api "github.com/pgillich/mimir-multitenant_alertmanager/pkg/api/notifyer"
+)
+
+func (s *NotifyerSuite) TestSimulate() {
+	log := logger.GetLogger(buildinfo.BuildInfo.AppName(), slog.LevelDebug).With(logger.KeyTestCase, s.T().Name())
+	ctx := logger.NewContext(context.Background(), log)
+	serverConfig := s.newNotifyerServerConfig("2525")
+
+	start := time.Date(2024, 12, 13, 19, 30, 0, 0, time.UTC)
+	highLatency := notifyer_api.GettableAlert{
+		Labels:   notifyer_api.LabelSet{"alertname": "HighLatency", "tenant": "devops"},
+		StartsAt: start.Add(-5 * time.Minute),
+		EndsAt:   start.Add(4 * time.Minute),
+	}
+	diskFull := notifyer_api.GettableAlert{
+		Labels: notifyer_api.LabelSet{"alertname": "DiskFull", "tenant": "app-development"},
+		EndsAt: start.Add(15 * time.Minute),
+	}
+
+	simulator, err := notifyer.NewSimulator(ctx, serverConfig.Alerts, serverConfig.Notifyer)
+	s.NoError(err, "NewSimulator")
+	timeline, err := simulator.Run(ctx, []notifyer.SimulationStep{
+		{Time: start, Source: "first", Alerts: notifyer_api.GettableAlerts{highLatency}},
+		{Time: start.Add(time.Minute), Alerts: notifyer_api.GettableAlerts{highLatency, diskFull}},
+		{Time: start.Add(5 * time.Minute), Alerts: notifyer_api.GettableAlerts{diskFull}},
+		{Time: start.Add(6 * time.Minute), Alerts: notifyer_api.GettableAlerts{diskFull}},
+	})
+	s.NoError(err, "Run")
+	if !s.Len(timeline, 4, "timeline") {
+		return
+	}
+
+	s.Equal(1, timeline[0].Step, "Step")
+	s.Equal("first", timeline[0].Source, "Source")
+	s.Equal(1, timeline[0].Firing, "Firing")
+	if s.Len(timeline[0].Notifications, 1, "Notifications") {
+		s.Equal(start, timeline[0].Notifications[0].Time, "Time")
+		s.Equal("email", timeline[0].Notifications[0].Receiver, "Receiver")
+		s.Equal("firing", timeline[0].Notifications[0].Status, "Status")
+		s.Equal([]notifyer_api.LabelSet{highLatency.Labels}, timeline[0].Notifications[0].Alerts, "Alerts")
+	}
+
+	s.Equal(2, timeline[1].Alerts, "Alerts")
+	if s.Len(timeline[1].Notifications, 1, "Notifications") {
+		s.Equal([]notifyer_api.LabelSet{diskFull.Labels}, timeline[1].Notifications[0].Alerts, "Alerts")
+	}
+
+	s.Equal(1, timeline[2].Resolved, "Resolved")
+	if s.Len(timeline[2].Notifications, 1, "Notifications") {
+		s.Equal(start.Add(5*time.Minute), timeline[2].Notifications[0].Time, "Time")
+		s.Equal("resolved", timeline[2].Notifications[0].Status, "Status")
+		s.Equal([]notifyer_api.LabelSet{highLatency.Labels}, timeline[2].Notifications[0].Alerts, "Alerts")
+	}
+
+	s.Empty(timeline[3].Notifications, "Notifications")
+}
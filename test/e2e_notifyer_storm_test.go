@@ -0,0 +1,121 @@
+package test
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"time"
+
+	am_config "github.com/prometheus/alertmanager/config"
+	"github.com/prometheus/client_golang/prometheus"
+
+	"github.com/pgillich/micro-server/pkg/logger"
+
+	"github.com/pgillich/mimir-multitenant_alertmanager/configs"
+	"github.com/pgillich/mimir-multitenant_alertmanager/internal/buildinfo"
+	notifyer "github.com/pgillich/mimir-multitenant_alertmanager/internal/notifyer"
+	notifyer_api "github.com/pgillich/mimir-multitenant_alertmanager/pkg/api/notifyer"
+)
+
+func (s *NotifyerSuite) TestStormProtection() {
+	log := logger.GetLogger(buildinfo.BuildInfo.AppName(), slog.LevelDebug).With(logger.KeyTestCase, s.T().Name())
+	ctx := logger.NewContext(context.Background(), log)
+	serverConfig := s.newNotifyerServerConfig("2525")
+	emailConfig := *serverConfig.Notifyer.Receivers[0].EmailConfigs[0]
+	emailConfig.To = "devops@localhost"
+	serverConfig.Notifyer.Receivers = append(serverConfig.Notifyer.Receivers[:1], am_config.Receiver{
+		Name: "devops", EmailConfigs: []*am_config.EmailConfig{&emailConfig},
+	})
+	serverConfig.Notifyer.Route.Routes = []*am_config.Route{{
+		Receiver: "devops", Match: map[string]string{"tenant": "devops"}, GroupByStr: []string{"alertname"},
+	}}
+	serverConfig.Notifyer.StormProtection = []configs.StormProtectionConfig{{
+		Receiver: "devops", WindowSec: 600, MaxNotifications: 2, StormThreshold: 5,
+	}}
+	serverConfig.Notifyer.Escalations = []configs.EscalationConfig{{
+		RouteID: `{}/{tenant="devops"}/0`, Steps: []configs.EscalationStepConfig{{Receiver: "email", DelaySec: 7200}},
+	}}
+	s.Empty(notifyer.ValidateConfig(serverConfig.Alerts, serverConfig.Notifyer), "ValidateConfig")
+	suppressedBefore := s.suppressedNotifications()
+
+	start := time.Now()
+	alerts := func(from int, to int, severity string) notifyer_api.GettableAlerts {
+		alerts := notifyer_api.GettableAlerts{}
+		for a := from; a < to; a++ {
+			alerts = append(alerts, notifyer_api.GettableAlert{
+				Labels:   notifyer_api.LabelSet{"alertname": fmt.Sprintf("Storm%02d", a), "tenant": "devops", "severity": severity},
+				StartsAt: start,
+				EndsAt:   start.Add(2 * time.Hour),
+			})
+		}
+
+		return alerts
+	}
+	steps := []notifyer.SimulationStep{
+		{Time: start, Alerts: alerts(0, 3, "warning")},
+		{Time: start.Add(15 * time.Minute), Alerts: append(alerts(0, 3, "warning"), alerts(3, 9, "critical")...)},
+		{Time: start.Add(20 * time.Minute), Alerts: append(alerts(0, 3, "warning"), alerts(3, 10, "critical")...)},
+		{Time: start.Add(40 * time.Minute), Alerts: append(alerts(0, 3, "warning"), alerts(3, 11, "critical")...)},
+	}
+
+	simulator, err := notifyer.NewSimulator(ctx, serverConfig.Alerts, serverConfig.Notifyer)
+	s.NoError(err, "NewSimulator")
+	timeline, err := simulator.Run(ctx, steps)
+	s.NoError(err, "Run")
+
+	notifications := []string{}
+	for _, entry := range timeline {
+		for _, notification := range entry.Notifications {
+			notifications = append(notifications, entry.Time.Sub(start).String()+" "+notification.Receiver+" "+notification.Subject)
+		}
+	}
+	s.Equal([]string{
+		"0s devops [FIRING:1] Storm00 (warning devops)",
+		"0s devops [FIRING:1] Storm01 (warning devops)",
+		"15m0s devops [STORM] devops: 1 firing, 0 resolved alerts held back",
+		"15m0s devops [STORM] devops: 6 firing, 0 resolved alerts held back",
+		"40m0s devops [STORM] devops: 7 firing, 0 resolved alerts held back",
+		"40m0s devops [FIRING:1] Storm10 (critical devops)",
+	}, notifications, "notifications")
+	s.Len(timeline[0].Escalations, 2, "escalations of the admitted groups")
+	if s.Len(timeline[1].Notifications, 2, "catch-up and storm summary") {
+		s.Contains(timeline[1].Notifications[0].Html,
+			"<tr><td>devops</td><td>Storm02</td><td>warning</td><td>1</td><td>0</td></tr>", "Html")
+		s.Contains(timeline[1].Notifications[1].Html,
+			"<tr><td>devops</td><td>Storm03</td><td>critical</td><td>1</td><td>0</td></tr>", "Html")
+	}
+	if s.Len(timeline[3].Notifications, 2, "catch-up summary") {
+		s.Contains(timeline[3].Notifications[0].Html,
+			"<tr><td>devops</td><td>Storm09</td><td>critical</td><td>1</td><td>0</td></tr>", "Html")
+	}
+	s.Equal(float64(8), s.suppressedNotifications()-suppressedBefore, "suppressed notifications")
+
+	serverConfig.Notifyer.StormProtection = []configs.StormProtectionConfig{
+		{Receiver: "devops", WindowSec: 600, MaxNotifications: -1},
+		{Receiver: "devops", StormThreshold: -1},
+		{Receiver: "unknown", WindowSec: 60},
+	}
+	s.Equal([]string{
+		"notifyer.stormProtection[0].maxNotifications",
+		"notifyer.stormProtection[1].receiver",
+		"notifyer.stormProtection[1].stormThreshold",
+		"notifyer.stormProtection[1].windowSec",
+		"notifyer.stormProtection[2].receiver",
+	}, configErrorPaths(notifyer.ValidateConfig(serverConfig.Alerts, serverConfig.Notifyer)), "ValidateConfig")
+}
+
+// suppressedNotifications returns the sum of the suppressed notifications metric
+func (s *NotifyerSuite) suppressedNotifications() float64 {
+	metricFamilies, err := prometheus.DefaultGatherer.Gather()
+	s.NoError(err, "Gather")
+	sum := 0.0
+	for _, metricFamily := range metricFamilies {
+		if metricFamily.GetName() == notifyer.MetricSuppressedNotifications+"_total" {
+			for _, metric := range metricFamily.GetMetric() {
+				sum += metric.GetCounter().GetValue()
+			}
+		}
+	}
+
+	return sum
+}
@@ -0,0 +1,89 @@
+package test
+
+import (
+	"context"
+	"log/slog"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/pgillich/micro-server/pkg/logger"
+
+	"github.com/pgillich/mimir-multitenant_alertmanager/internal/buildinfo"
+	notifyer "github.com/pgillich/mimir-multitenant_alertmanager/internal/notifyer"
+	notifyer_api "github.com/pgillich/mimir-multitenant_alertmanager/pkg/api/notifyer"
+)
+
+func (s *NotifyerSuite) TestTemplateFiles() {
+	log := logger.GetLogger(buildinfo.BuildInfo.AppName(), slog.LevelDebug).With(logger.KeyTestCase, s.T().Name())
+	ctx := logger.NewContext(context.Background(), log)
+	templateDir := s.T().TempDir()
+	templateFile := filepath.Join(templateDir, "custom.tmpl")
+	writeTemplate := func(version string) {
+		s.NoError(os.WriteFile(templateFile, []byte(`{{ define "custom.subject" }}`+version+` {{ .Status }}{{ end }}`), 0o600), "WriteFile")
+	}
+	writeTemplate("v1")
+	serverConfig := s.newNotifyerServerConfig("2525")
+	serverConfig.Notifyer.TemplateFiles = []string{filepath.Join(templateDir, "*.tmpl")}
+	serverConfig.Notifyer.Receivers[0].EmailConfigs[0].Headers["Subject"] = `{{ template "custom.subject" . }}`
+	s.Empty(notifyer.ValidateConfig(serverConfig.Alerts, serverConfig.Notifyer), "ValidateConfig")
+
+	start := time.Now()
+	alerts := notifyer_api.GettableAlerts{}
+	simulator, err := notifyer.NewSimulator(ctx, serverConfig.Alerts, serverConfig.Notifyer)
+	s.NoError(err, "NewSimulator")
+	step := func(minutes int, alertname string) []string {
+		alerts = append(alerts, notifyer_api.GettableAlert{
+			Labels: notifyer_api.LabelSet{"alertname": alertname, "tenant": "devops"},
+			EndsAt: start.Add(time.Hour),
+		})
+		entry, err := simulator.Step(ctx, notifyer.SimulationStep{Time: start.Add(time.Duration(minutes) * time.Minute), Alerts: alerts})
+		s.NoError(err, "Step")
+		subjects := []string{}
+		for _, notification := range entry.Notifications {
+			subjects = append(subjects, notification.Subject)
+		}
+
+		return subjects
+	}
+
+	s.Equal([]string{"v1 firing"}, step(0, "First"), "v1")
+	status := simulator.TemplateStatus()
+	s.Equal([]string{templateFile}, status.Files, "Files")
+	s.Nil(status.Error, "Error")
+
+	writeTemplate("v2")
+	s.Equal([]string{"v2 firing"}, step(10, "Second"), "v2")
+	v2Status := simulator.TemplateStatus()
+	s.NotEqual(status.Checksum, v2Status.Checksum, "Checksum")
+	s.Equal(start.Add(10*time.Minute), v2Status.LoadedAt, "LoadedAt")
+
+	s.NoError(os.WriteFile(templateFile, []byte(`{{ define "custom.subject" }}v3 {{ .Status }`), 0o600), "WriteFile")
+	s.Equal([]string{"v2 firing"}, step(20, "Third"), "last good templates")
+	status = simulator.TemplateStatus()
+	s.Equal(v2Status.Checksum, status.Checksum, "Checksum")
+	if s.NotNil(status.Error, "Error") {
+		s.Contains(*status.Error, templateFile, "Error")
+	}
+	if s.NotNil(status.FailedAt, "FailedAt") {
+		s.Equal(start.Add(20*time.Minute), *status.FailedAt, "FailedAt")
+	}
+	serverConfig.Notifyer.TemplateFiles = append(serverConfig.Notifyer.TemplateFiles, filepath.Join(templateDir, "*.missing"), "[")
+	s.Equal([]string{
+		"notifyer.templateFiles[0]",
+		"notifyer.templateFiles[1]",
+		"notifyer.templateFiles[2]",
+	}, configErrorPaths(notifyer.ValidateConfig(serverConfig.Alerts, serverConfig.Notifyer)), "ValidateConfig")
+
+	writeTemplate("v4")
+	s.Equal([]string{"v4 firing"}, step(30, "Fourth"), "v4")
+	s.Nil(simulator.TemplateStatus().Error, "Error")
+
+	s.NoError(os.Rename(templateFile, templateFile+".bak"), "Rename")
+	s.Equal([]string{"v4 firing"}, step(40, "Fifth"), "last good templates without files")
+	if status = simulator.TemplateStatus(); s.NotNil(status.Error, "Error without files") {
+		s.Contains(*status.Error, "matches no template file", "Error without files")
+	}
+	_, err = notifyer.NewSimulator(ctx, serverConfig.Alerts, serverConfig.Notifyer)
+	s.ErrorIs(err, notifyer.ErrTemplateFiles, "NewSimulator without files")
+}
@@ -0,0 +1,96 @@
+package test
+
+import (
+	"context"
+	"log/slog"
+	"os"
+	"path/filepath"
+	"time"
+
+	am_config "github.com/prometheus/alertmanager/config"
+
+	"github.com/pgillich/micro-server/pkg/logger"
+
+	"github.com/pgillich/mimir-multitenant_alertmanager/configs"
+	"github.com/pgillich/mimir-multitenant_alertmanager/internal/buildinfo"
+	notifyer "github.com/pgillich/mimir-multitenant_alertmanager/internal/notifyer"
+	notifyer_api "github.com/pgillich/mimir-multitenant_alertmanager/pkg/api/notifyer"
+)
+
+func (s *NotifyerSuite) TestTenantConfigs() {
+	log := logger.GetLogger(buildinfo.BuildInfo.AppName(), slog.LevelDebug).With(logger.KeyTestCase, s.T().Name())
+	ctx := logger.NewContext(context.Background(), log)
+	serverConfig := s.newNotifyerServerConfig("2525")
+	serverConfig.Notifyer.TenantConfigPath = "../testdata/notifier/tenants"
+	serverConfig.Notifyer.TenantEmailDefaults = serverConfig.Notifyer.Receivers[0].EmailConfigs[0]
+	// the tenant routes are appended, the route IDs of the central routes don't change
+	serverConfig.Notifyer.Route.Routes = []*am_config.Route{{Receiver: "email", Match: map[string]string{"tenant": "app-development"}}}
+	serverConfig.Notifyer.RouteFlapDetection = []configs.RouteFlapDetectionConfig{
+		{RouteID: `{}/{tenant="app-development"}/0`, Threshold: 3, WindowSec: 3600},
+	}
+	s.Empty(notifyer.ValidateConfig(serverConfig.Alerts, serverConfig.Notifyer), "ValidateConfig")
+
+	start := time.Now()
+	alert := func(tenant string, severity string) notifyer_api.GettableAlert {
+		return notifyer_api.GettableAlert{
+			Labels: notifyer_api.LabelSet{"alertname": "Tenant", "tenant": tenant, "severity": severity},
+			EndsAt: start.Add(time.Hour),
+		}
+	}
+	simulator, err := notifyer.NewSimulator(ctx, serverConfig.Alerts, serverConfig.Notifyer)
+	s.NoError(err, "NewSimulator")
+	entry, err := simulator.Step(ctx, notifyer.SimulationStep{Time: start, Alerts: notifyer_api.GettableAlerts{
+		alert("devops", "warning"), alert("devops", "critical"), alert("app-development", "critical"),
+	}})
+	s.NoError(err, "Step")
+
+	notifications := []string{}
+	for _, notification := range entry.Notifications {
+		notifications = append(notifications, notification.Receiver+" "+notification.To+" "+notification.Subject)
+	}
+	s.ElementsMatch([]string{
+		"email testuser@localhost [FIRING:1]  (Tenant critical app-development)",
+		"devops/oncall devops-oncall@localhost [devops] firing: Tenant",
+		"devops/team devops-team@localhost [devops] firing: Tenant",
+	}, notifications, "notifications")
+
+	tenantDir := s.T().TempDir()
+	s.NoError(os.WriteFile(filepath.Join(tenantDir, "devops.yaml"), []byte(`
+route:
+  receiver: email
+  routes:
+    - receiver: team
+      match:
+        tenant: app-development
+    - receiver: team
+      matchers:
+        - tenant=~"app-.*"
+receivers:
+  - name: team
+templates:
+  - devops.tmpl
+`), 0o600), "devops.yaml")
+	s.NoError(os.WriteFile(filepath.Join(tenantDir, "devops.tmpl"), []byte(
+		`{{ define "email.default.subject" }}hijacked{{ end }}`), 0o600), "devops.tmpl")
+	s.NoError(os.WriteFile(filepath.Join(tenantDir, "unknown.yml"), []byte(`
+route:
+  receiver: team
+receivers:
+  - name: team
+`), 0o600), "unknown.yml")
+	serverConfig.Notifyer.TenantConfigPath = tenantDir
+	// a central route without continue catches all alerts of the tenant before the tenant route
+	serverConfig.Notifyer.Route.Routes = append(serverConfig.Notifyer.Route.Routes,
+		&am_config.Route{Receiver: "email", Match: map[string]string{"tenant": "devops"}})
+	devopsPath := "notifyer.tenantConfigPath[" + filepath.Join(tenantDir, "devops.yaml") + "[0]]"
+	s.Equal([]string{
+		devopsPath + ".route.receiver",
+		devopsPath + ".route.routes[0].match.tenant",
+		devopsPath + ".route.routes[1].matchers[0]",
+		devopsPath + ".route",
+		devopsPath + ".templates[0]",
+		"notifyer.tenantConfigPath[" + filepath.Join(tenantDir, "unknown.yml") + "[0]].tenant",
+	}, configErrorPaths(notifyer.ValidateConfig(serverConfig.Alerts, serverConfig.Notifyer)), "ValidateConfig")
+	_, err = notifyer.NewSimulator(ctx, serverConfig.Alerts, serverConfig.Notifyer)
+	s.ErrorIs(err, notifyer.ErrInvalidTenantConfig, "invalid tenant config")
+}
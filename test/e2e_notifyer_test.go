@@ -16,7 +16,6 @@ import (
 	"github.com/pgillich/micro-server/pkg/logger"
 	mw_client "github.com/pgillich/micro-server/pkg/middleware/client"
 	mw_client_model "github.com/pgillich/micro-server/pkg/middleware/client/model"
-	srv_testutil "github.com/pgillich/micro-server/pkg/testutil"
 	srv_utils "github.com/pgillich/micro-server/pkg/utils"
 
 	"github.com/pgillich/mimir-multitenant_alertmanager/configs"
@@ -28,6 +27,7 @@ import (
 	// force to run init() functions
 	_ "github.com/pgillich/mimir-multitenant_alertmanager/internal/alertmanager"
 	_ "github.com/pgillich/mimir-multitenant_alertmanager/internal/notifyer"
+	_ "github.com/pgillich/mimir-multitenant_alertmanager/internal/webui"
 )
 
 type NotifyerSuite struct {
@@ -118,8 +118,7 @@ func (s *NotifyerSuite) TestNotify0() {
 	<-smtpStarted
 	time.Sleep(1 * time.Second)
 
-	server := srv_testutil.RunTestServerCmd(s.T(), "services",
-		buildinfo.BuildInfo, serverConfig, testConfig, []string{"multitenant-alertmanager", "notifyer"}, log)
+	server := runTestServer(s.T(), serverConfig, testConfig, []string{"multitenant-alertmanager", "notifyer"}, log)
 	defer server.Cancel()
 
 	testRootUrl, err := url.JoinPath(server.TestServer.URL, "/notifyer/api/v2")
@@ -131,6 +130,7 @@ func (s *NotifyerSuite) TestNotify0() {
 	s.NoError(err, "srv_api.NewClientWithResponses")
 
 	clientCtx := logger.NewContext(context.Background(), log)
+	s.waitNotifyerAlerts(clientCtx, testRootUrl)
 	clientResp, err := mimirClient.GetAlertsWithResponse(clientCtx, &srv_api.GetAlertsParams{})
 	s.NoError(err, "GetAlertsWithResponse")
 
@@ -139,6 +139,4 @@ func (s *NotifyerSuite) TestNotify0() {
 
 	bodyStr := string(bodyYaml)
 	s.T().Logf("Client Resp\n%s", bodyStr)
-
-	time.Sleep(1000 * time.Second)
 }
@@ -0,0 +1,106 @@
+package test
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	am_config "github.com/prometheus/alertmanager/config"
+
+	"github.com/pgillich/micro-server/pkg/logger"
+
+	"github.com/pgillich/mimir-multitenant_alertmanager/configs"
+	"github.com/pgillich/mimir-multitenant_alertmanager/internal/buildinfo"
+	notifyer "github.com/pgillich/mimir-multitenant_alertmanager/internal/notifyer"
+	notifyer_api "github.com/pgillich/mimir-multitenant_alertmanager/pkg/api/notifyer"
+)
+
+func (s *NotifyerSuite) TestTimeIntervals() {
+	log := logger.GetLogger(buildinfo.BuildInfo.AppName(), slog.LevelDebug).With(logger.KeyTestCase, s.T().Name())
+	ctx := logger.NewContext(context.Background(), log)
+	serverConfig := s.newNotifyerServerConfig("2525")
+	serverConfig.Notifyer.Receivers[0].EmailConfigs[0].Headers = map[string]string{"Subject": `{{ .Status }}`}
+	serverConfig.Notifyer.TimeIntervals = []configs.TimeIntervalConfig{{
+		Name: "business-hours",
+		TimeIntervals: []configs.TimeIntervalSpec{{
+			Times:    []configs.TimeRangeConfig{{StartTime: "09:00", EndTime: "17:00"}},
+			Weekdays: []string{"monday:friday"},
+			Location: "Europe/Budapest",
+		}},
+	}}
+	serverConfig.Notifyer.Route.Routes = []*am_config.Route{{
+		Receiver:            "email",
+		Match:               map[string]string{"tenant": "devops"},
+		ActiveTimeIntervals: []string{"business-hours"},
+	}}
+
+	// 06:00 UTC is 07:00 in Budapest, the business hours start at 08:00 UTC
+	start := time.Date(2024, 12, 12, 6, 0, 0, 0, time.UTC)
+	alertAt := func(alertname string, tenant string, endsAt time.Duration) notifyer_api.GettableAlert {
+		return notifyer_api.GettableAlert{
+			Labels:   notifyer_api.LabelSet{"alertname": alertname, "tenant": tenant},
+			StartsAt: start,
+			EndsAt:   start.Add(endsAt),
+		}
+	}
+	steps := []notifyer.SimulationStep{
+		{Time: start, Alerts: notifyer_api.GettableAlerts{
+			alertAt("Lasting", "devops", 4*time.Hour),
+			alertAt("Transient", "devops", 30*time.Minute),
+			alertAt("Lasting", "app-development", 4*time.Hour),
+		}},
+		{Time: start.Add(time.Hour), Alerts: notifyer_api.GettableAlerts{
+			alertAt("Lasting", "devops", 4*time.Hour),
+			alertAt("Lasting", "app-development", 4*time.Hour),
+		}},
+		{Time: start.Add(2 * time.Hour), Alerts: notifyer_api.GettableAlerts{
+			alertAt("Lasting", "devops", 4*time.Hour),
+			alertAt("Lasting", "app-development", 4*time.Hour),
+		}},
+	}
+
+	simulator, err := notifyer.NewSimulator(ctx, serverConfig.Alerts, serverConfig.Notifyer)
+	s.NoError(err, "NewSimulator")
+	timeline, err := simulator.Run(ctx, steps)
+	s.NoError(err, "Run")
+
+	notifications := map[string][]string{}
+	for _, entry := range timeline {
+		for _, notification := range entry.Notifications {
+			for _, alert := range notification.Alerts {
+				notifications[alert["tenant"]] = append(notifications[alert["tenant"]],
+					entry.Time.Sub(start).String()+" "+alert["alertname"]+" "+notification.Subject)
+			}
+		}
+	}
+	s.Equal([]string{"2h0m0s Lasting firing"}, notifications["devops"], "devops")
+	s.Equal([]string{"0s Lasting firing"}, notifications["app-development"], "app-development")
+
+	serverConfig.Notifyer.Receivers[0].EmailConfigs[0].Headers = map[string]string{
+		"Subject": `{{ if .CommonAnnotations.fail }}{{ template "missing" . }}{{ end }}{{ .Status }}`,
+	}
+	failing := alertAt("Lasting", "devops", 4*time.Hour)
+	failing.Annotations = notifyer_api.LabelSet{"fail": "true"}
+	simulator, err = notifyer.NewSimulator(ctx, serverConfig.Alerts, serverConfig.Notifyer)
+	s.NoError(err, "NewSimulator failing release")
+	_, err = simulator.Step(ctx, notifyer.SimulationStep{Time: start, Alerts: notifyer_api.GettableAlerts{failing}})
+	s.NoError(err, "Step muted")
+	_, err = simulator.Step(ctx, notifyer.SimulationStep{Time: start.Add(2 * time.Hour), Alerts: notifyer_api.GettableAlerts{failing}})
+	s.Error(err, "Step failing release")
+	entry, err := simulator.Step(ctx, notifyer.SimulationStep{
+		Time: start.Add(3 * time.Hour), Alerts: notifyer_api.GettableAlerts{alertAt("Lasting", "devops", 4*time.Hour)},
+	})
+	s.NoError(err, "Step released again")
+	if s.Len(entry.Notifications, 1, "released again") {
+		s.Equal("firing", entry.Notifications[0].Subject, "Subject")
+	}
+
+	serverConfig.Notifyer.TimeIntervals[0].TimeIntervals[0].Location = "Mars/Olympus_Mons"
+	serverConfig.Notifyer.Route.Routes[0].MuteTimeIntervals = []string{"night"}
+	s.Equal([]string{
+		"notifyer.route.routes[0].muteTimeIntervals[0]",
+		"notifyer.timeIntervals[0].timeIntervals[0]",
+	}, configErrorPaths(notifyer.ValidateConfig(serverConfig.Alerts, serverConfig.Notifyer)), "ValidateConfig")
+	_, err = notifyer.NewSimulator(ctx, serverConfig.Alerts, serverConfig.Notifyer)
+	s.ErrorIs(err, notifyer.ErrInvalidTimeInterval, "invalid location")
+}
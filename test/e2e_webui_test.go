@@ -0,0 +1,83 @@
+package test
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"log/slog"
+	"net/http"
+	"net/url"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+
+	"github.com/pgillich/micro-server/pkg/logger"
+	mw_client "github.com/pgillich/micro-server/pkg/middleware/client"
+	mw_client_model "github.com/pgillich/micro-server/pkg/middleware/client/model"
+	srv_utils "github.com/pgillich/micro-server/pkg/utils"
+
+	"github.com/pgillich/mimir-multitenant_alertmanager/configs"
+	"github.com/pgillich/mimir-multitenant_alertmanager/internal/buildinfo"
+	"github.com/pgillich/mimir-multitenant_alertmanager/internal/webui"
+
+	// force to run init() functions
+	_ "github.com/pgillich/mimir-multitenant_alertmanager/internal/alertmanager"
+)
+
+type WebUISuite struct {
+	suite.Suite
+}
+
+func TestWebUISuite(t *testing.T) {
+	suite.Run(t, new(WebUISuite))
+}
+
+func (s *WebUISuite) TestStatic() {
+	log := logger.GetLogger(buildinfo.BuildInfo.AppName(), slog.LevelDebug).With(logger.KeyTestCase, s.T().Name())
+	serverConfig := &configs.ServerConfig{
+		Alerts: &configs.AlertsConfig{
+			AlertmanagerUrl: "http://localhost:8085/alertmanager/api/v2",
+			Tenants:         []string{"devops", "app-development"},
+			TenantLabel:     "org",
+		},
+	}
+	testConfig := &configs.TestConfig{
+		CaptureTransportMode: mw_client_model.CaptureTransportModeFake,
+		CaptureDir:           "../testdata/capture",
+		CaptureMatchers: []mw_client_model.CaptureMatcher{
+			mw_client.CaptureEqualRequestURLAndHeader(configs.HttpHeaderXscopeorgid),
+		},
+	}
+
+	server := runTestServer(s.T(), serverConfig, testConfig, []string{"multitenant-alertmanager", "ui"}, log)
+	defer server.Cancel()
+
+	httpClient := srv_utils.NewHttpClient()
+	clientCtx := logger.NewContext(context.Background(), log)
+
+	for _, page := range []string{"/ui/", "/ui/alert.html", "/ui/app.js", "/ui/style.css"} {
+		pageUrl, err := url.JoinPath(server.TestServer.URL, page)
+		s.NoError(err, page)
+		req, err := http.NewRequestWithContext(clientCtx, http.MethodGet, pageUrl, http.NoBody)
+		s.NoError(err, page)
+		resp, err := httpClient.Do(req)
+		s.NoError(err, page)
+		body, err := io.ReadAll(resp.Body)
+		s.NoError(err, page)
+		resp.Body.Close()
+		s.Equal(http.StatusOK, resp.StatusCode, page)
+		s.NotEmpty(body, page)
+	}
+
+	settingsUrl, err := url.JoinPath(server.TestServer.URL, "/ui/config.json")
+	s.NoError(err, "settingsUrl")
+	req, err := http.NewRequestWithContext(clientCtx, http.MethodGet, settingsUrl, http.NoBody)
+	s.NoError(err, "settingsUrl")
+	resp, err := httpClient.Do(req)
+	s.NoError(err, "config.json")
+	defer resp.Body.Close()
+	settings := webui.Settings{}
+	s.NoError(json.NewDecoder(resp.Body).Decode(&settings), "config.json")
+	s.Equal("org", settings.TenantLabel, "TenantLabel")
+	s.Equal("/multitenant-alertmanager/api/v2", settings.ApiUrl, "ApiUrl")
+}
@@ -4,6 +4,8 @@ import (
 	"fmt"
 	"io"
 	"log/slog"
+	"net"
+	"time"
 
 	smtp "github.com/emersion/go-smtp"
 )
@@ -65,3 +67,27 @@ func (s *SmtpSession) Logout() error {
 	s.Logger.Info("SMTPD_Logout")
 	return nil
 }
+
+// StartSmtpServer starts a test SMTP server, which accepts testuser/testpass
+func StartSmtpServer(log *slog.Logger, addr string) *smtp.Server {
+	smtpServer := smtp.NewServer(&SmtpBackend{Logger: log})
+	smtpServer.Addr = addr
+	smtpServer.Domain = "localhost"
+	smtpServer.WriteTimeout = 10 * time.Second
+	smtpServer.ReadTimeout = 10 * time.Second
+	smtpServer.MaxMessageBytes = 1024 * 1024
+	smtpServer.MaxRecipients = 50
+	smtpServer.AllowInsecureAuth = true
+	listener, err := net.Listen("tcp", addr)
+	if err != nil {
+		log.Error("SMTPD_Listen", "error", err)
+		return smtpServer
+	}
+	go func() {
+		if err := smtpServer.Serve(listener); err != nil {
+			log.Info("SMTPD_Serve", "error", err)
+		}
+	}()
+
+	return smtpServer
+}
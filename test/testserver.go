@@ -0,0 +1,49 @@
+package test
+
+import (
+	"context"
+	"log/slog"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/pgillich/micro-server/pkg/logger"
+	"github.com/pgillich/micro-server/pkg/server"
+	srv_testutil "github.com/pgillich/micro-server/pkg/testutil"
+
+	"github.com/pgillich/mimir-multitenant_alertmanager/configs"
+	"github.com/pgillich/mimir-multitenant_alertmanager/internal/buildinfo"
+)
+
+// runTestServer runs the services on a test server with exactly the given server config.
+// srv_testutil.RunTestServerCmd is not used, because its services command keeps the non-empty
+// config values of the previous runs in a global viper, so a test could get the receivers,
+// routes and dry-run settings of an earlier test.
+func runTestServer(t *testing.T, serverConfig *configs.ServerConfig, testConfig *configs.TestConfig,
+	services []string, log *slog.Logger,
+) *srv_testutil.TestServer {
+	t.Helper()
+	ctx := logger.NewContext(context.Background(), log)
+	testServer := &srv_testutil.TestServer{
+		TestServer: httptest.NewUnstartedServer(nil),
+	}
+	testServer.Addr = testServer.TestServer.Listener.Addr().String()
+	testServer.Ctx, testServer.Cancel = context.WithCancel(ctx)
+	serverConfig.ListenAddr = testServer.Addr
+
+	started := make(chan struct{})
+	testConfig.SetHttpServerRunner(srv_testutil.HttpTestserverRunner(testServer.TestServer, started))
+	failed := make(chan error, 1)
+	go func() {
+		if err := server.RunServices(testServer.Ctx, buildinfo.BuildInfo, services, serverConfig, testConfig); err != nil {
+			failed <- err
+		}
+	}()
+	select {
+	case <-started:
+	case err := <-failed:
+		testServer.Cancel()
+		t.Fatalf("RunServices: %v", err)
+	}
+
+	return testServer
+}